@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
 
+	"github.com/yourusername/jira-daily-report/internal/config"
 	"github.com/yourusername/jira-daily-report/internal/oauth"
 )
 
@@ -120,11 +122,11 @@ var loginCmd = &cobra.Command{
 		}
 
 		// Create OAuth configuration
-		config := oauth.NewConfig(clientID, clientSecret, callbackURL)
-		authenticator := oauth.NewAuthenticator(config)
+		oauthConfig := oauth.NewConfig(clientID, clientSecret, callbackURL)
+		authenticator := oauth.NewAuthenticatorWithStore(oauthConfig, config.TokenStore())
 
 		// Perform authentication
-		ctx := context.Background()
+		ctx := cmd.Context()
 		token, err := authenticator.Authenticate(ctx)
 		if err != nil {
 			log.Fatalf("Authentication failed: %v", err)
@@ -137,6 +139,17 @@ var loginCmd = &cobra.Command{
 			fmt.Printf("\n✓ Logged in as: %s (%s)\n", userInfo.Name, userInfo.Email)
 		}
 
+		// Pick the Jira site requests go to, rather than guessing later
+		sites, err := client.GetAccessibleResources(ctx)
+		if err != nil {
+			log.Fatalf("Failed to get sites: %v", err)
+		}
+		site, err := chooseSite(sites)
+		if err != nil {
+			log.Fatalf("Failed to select site: %v", err)
+		}
+		saveSite(site)
+
 		// Show token info
 		fmt.Println("\n=== Authentication Successful ===")
 		fmt.Printf("Expires At: %s\n", token.Expiry.Format(time.RFC3339))
@@ -150,8 +163,8 @@ var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Remove stored OAuth credentials",
 	Run: func(cmd *cobra.Command, args []string) {
-		config := oauth.NewConfig("", "", "")
-		authenticator := oauth.NewAuthenticator(config)
+		oauthConfig := oauth.NewConfig("", "", "")
+		authenticator := oauth.NewAuthenticatorWithStore(oauthConfig, config.TokenStore())
 
 		if err := authenticator.Logout(); err != nil {
 			log.Fatalf("Logout failed: %v", err)
@@ -163,8 +176,8 @@ var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show current authentication status",
 	Run: func(cmd *cobra.Command, args []string) {
-		config := oauth.NewConfig("", "", "")
-		authenticator := oauth.NewAuthenticator(config)
+		oauthConfig := oauth.NewConfig("", "", "")
+		authenticator := oauth.NewAuthenticatorWithStore(oauthConfig, config.TokenStore())
 
 		if !authenticator.HasToken() {
 			fmt.Println("Not authenticated")
@@ -206,7 +219,7 @@ var statusCmd = &cobra.Command{
 		}
 
 		// Try to get user info
-		ctx := context.Background()
+		ctx := cmd.Context()
 		client := oauth.NewAtlassianClient(token)
 		if userInfo, err := client.GetUserInfo(ctx); err == nil {
 			fmt.Printf("\nLogged in as: %s (%s)\n", userInfo.Name, userInfo.Email)
@@ -218,54 +231,150 @@ var sitesCmd = &cobra.Command{
 	Use:   "sites",
 	Short: "List accessible Jira sites",
 	Run: func(cmd *cobra.Command, args []string) {
-		// Load credentials from keyring
-		creds, err := oauth.LoadAppCredentials()
-		if err != nil {
-			log.Fatalf("OAuth credentials not found. Run 'jira-report auth init' first")
+		sites := fetchAccessibleSites(cmd.Context())
+		if len(sites) == 0 {
+			fmt.Println("No accessible Jira sites found.")
+			return
 		}
 
-		// Allow env override
-		clientID := creds.ClientID
-		clientSecret := creds.ClientSecret
-		callbackURL := creds.CallbackURL
-
-		if envID := os.Getenv("JIRA_OAUTH_CLIENT_ID"); envID != "" {
-			clientID = envID
+		// Mark the site requests go to
+		currentID := ""
+		if server := config.ConfiguredJiraServer(); server != "" {
+			if current, err := oauth.MatchSite(sites, server); err == nil {
+				currentID = current.ID
+			}
 		}
-		if envSecret := os.Getenv("JIRA_OAUTH_CLIENT_SECRET"); envSecret != "" {
-			clientSecret = envSecret
+
+		fmt.Println("=== Accessible Jira Sites ===")
+		for i, site := range sites {
+			marker := ""
+			if site.ID == currentID {
+				marker = " (current)"
+			}
+			fmt.Printf("\n%d. %s%s\n", i+1, site.Name, marker)
+			fmt.Printf("   URL: %s\n", site.URL)
+			fmt.Printf("   Cloud ID: %s\n", site.ID)
 		}
-		if envURL := os.Getenv("JIRA_OAUTH_CALLBACK_URL"); envURL != "" {
-			callbackURL = envURL
+		if currentID == "" {
+			fmt.Println("\nNo site selected. Run 'jira-report auth sites select' to choose one.")
 		}
+	},
+}
 
-		config := oauth.NewConfig(clientID, clientSecret, callbackURL)
-		authenticator := oauth.NewAuthenticator(config)
-
-		ctx := context.Background()
-		token, err := authenticator.Authenticate(ctx)
-		if err != nil {
-			log.Fatalf("Authentication failed: %v", err)
+var sitesSelectCmd = &cobra.Command{
+	Use:   "select [url|name|cloud-id]",
+	Short: "Choose the Jira site to use with OAuth",
+	Long:  `Choose the Jira site used with OAuth and save it (URL and cloud ID) to the config file. Without an argument, the sites are listed to pick from.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		sites := fetchAccessibleSites(cmd.Context())
+
+		var site *oauth.AtlassianSite
+		var err error
+		if len(args) == 1 {
+			site, err = oauth.MatchSite(sites, args[0])
+		} else {
+			site, err = promptSite(sites)
 		}
-
-		client := oauth.NewAtlassianClient(token)
-		sites, err := client.GetAccessibleResources(ctx)
 		if err != nil {
-			log.Fatalf("Failed to get sites: %v", err)
+			log.Fatalf("Failed to select site: %v", err)
 		}
 
-		if len(sites) == 0 {
-			fmt.Println("No accessible Jira sites found.")
-			return
-		}
+		saveSite(site)
+	},
+}
 
-		fmt.Println("=== Accessible Jira Sites ===")
-		for i, site := range sites {
-			fmt.Printf("\n%d. %s\n", i+1, site.Name)
-			fmt.Printf("   URL: %s\n", site.URL)
-			fmt.Printf("   Cloud ID: %s\n", site.ID)
+// fetchAccessibleSites authenticates and returns the Jira sites the login can access
+func fetchAccessibleSites(ctx context.Context) []oauth.AtlassianSite {
+	// Load credentials from keyring
+	creds, err := oauth.LoadAppCredentials()
+	if err != nil {
+		log.Fatalf("OAuth credentials not found. Run 'jira-report auth init' first")
+	}
+
+	// Allow env override
+	clientID := creds.ClientID
+	clientSecret := creds.ClientSecret
+	callbackURL := creds.CallbackURL
+
+	if envID := os.Getenv("JIRA_OAUTH_CLIENT_ID"); envID != "" {
+		clientID = envID
+	}
+	if envSecret := os.Getenv("JIRA_OAUTH_CLIENT_SECRET"); envSecret != "" {
+		clientSecret = envSecret
+	}
+	if envURL := os.Getenv("JIRA_OAUTH_CALLBACK_URL"); envURL != "" {
+		callbackURL = envURL
+	}
+
+	oauthConfig := oauth.NewConfig(clientID, clientSecret, callbackURL)
+	authenticator := oauth.NewAuthenticatorWithStore(oauthConfig, config.TokenStore())
+
+	token, err := authenticator.Authenticate(ctx)
+	if err != nil {
+		log.Fatalf("Authentication failed: %v", err)
+	}
+
+	client := oauth.NewAtlassianClient(token)
+	sites, err := client.GetAccessibleResources(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get sites: %v", err)
+	}
+	return sites
+}
+
+// chooseSite picks the Jira site after login: the configured server if the login can access it,
+// the only accessible site if no server is configured, and otherwise asks
+func chooseSite(sites []oauth.AtlassianSite) (*oauth.AtlassianSite, error) {
+	if len(sites) == 0 {
+		return nil, fmt.Errorf("no accessible Jira sites found")
+	}
+
+	server := config.ConfiguredJiraServer()
+	if server != "" {
+		if site, err := oauth.MatchSite(sites, server); err == nil {
+			return site, nil
 		}
-	},
+		fmt.Printf("\n⚠️  The configured Jira server %s is not accessible with this login.\n", server)
+	} else if len(sites) == 1 {
+		return &sites[0], nil
+	}
+
+	return promptSite(sites)
+}
+
+// promptSite lists the sites and reads the number of the chosen one
+func promptSite(sites []oauth.AtlassianSite) (*oauth.AtlassianSite, error) {
+	if len(sites) == 0 {
+		return nil, fmt.Errorf("no accessible Jira sites found")
+	}
+
+	fmt.Println("\n=== Choose a Jira Site ===")
+	for i, site := range sites {
+		fmt.Printf("%d. %s (%s)\n", i+1, site.Name, site.URL)
+	}
+	fmt.Printf("Site [1-%d]: ", len(sites))
+
+	input, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || choice < 1 || choice > len(sites) {
+		return nil, fmt.Errorf("invalid choice %q", strings.TrimSpace(input))
+	}
+	return &sites[choice-1], nil
+}
+
+// saveSite makes site the Jira server in the config file
+func saveSite(site *oauth.AtlassianSite) {
+	if err := config.SaveOAuthSite(config.Site{CloudID: site.ID, URL: site.URL, Name: site.Name}); err != nil {
+		log.Fatalf("Failed to save site: %v", err)
+	}
+	fmt.Printf("✓ Using Jira site: %s (%s)\n", site.Name, site.URL)
+	if server := os.Getenv("JIRA_SERVER"); server != "" {
+		fmt.Printf("Note: JIRA_SERVER=%s overrides the saved site.\n", server)
+	}
 }
 
 func getStatus(token *oauth2.Token) string {
@@ -285,6 +394,7 @@ func init() {
 	authCmd.AddCommand(logoutCmd)
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(sitesCmd)
+	sitesCmd.AddCommand(sitesSelectCmd)
 
 	// Add flags
 	statusCmd.Flags().BoolP("verbose", "v", false, "Show token details")
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// checkTimeout bounds each live connectivity check
+const checkTimeout = 20 * time.Second
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a setting of the active profile",
+	Long: `Set a setting of the active profile in the config file. Nested settings use a
+dot (timerRounding.minutes), lists are comma-separated (blockedStatuses "Blocked,On Hold"),
+an empty value removes the setting, and API tokens are stored in the OS keyring.
+
+Settings:
+  ` + strings.Join(config.SettingKeys(), "\n  "),
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetValue(args[0], args[1]); err != nil {
+			log.Fatal("Failed to set config:", err)
+		}
+		fmt.Printf("✓ Set %s\n", args[0])
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a setting of the active profile",
+	Long: `Print the effective value of a setting of the active profile, including
+environment overrides. API tokens are masked and unset settings print nothing.
+
+Settings:
+  ` + strings.Join(config.SettingKeys(), "\n  "),
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		value, err := config.GetValue(args[0])
+		if err != nil {
+			log.Fatal("Failed to get config:", err)
+		}
+		fmt.Println(value)
+	},
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration and that the Jira and Tempo credentials work",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checks := config.ValidateSchema()
+		if allPassed(checks) {
+			checks = append(checks, connectivityChecks(cmd.Context())...)
+		}
+		if !printChecks(checks) {
+			os.Exit(1)
+		}
+	},
+}
+
+// connectivityChecks proves the Jira and Tempo credentials of the active profile work
+func connectivityChecks(ctx context.Context) []config.Check {
+	cfg, err := config.NewManager()
+	if err != nil {
+		return []config.Check{{Name: "Configuration", Err: err, Hint: "Run 'jira-report config validate'"}}
+	}
+
+	jiraClient, tempoClient, err := buildAPIClients(ctx, cfg)
+	if err != nil {
+		return []config.Check{{Name: "Jira site", Err: err, Hint: "Choose the site with 'jira-report auth sites select'"}}
+	}
+	return credentialChecks(ctx, cfg, jiraClient, tempoClient)
+}
+
+// credentialChecks calls Jira and Tempo with the clients to prove their credentials work
+func credentialChecks(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) []config.Check {
+	checks, _ := jiraCredentialChecks(ctx, cfg, jiraClient)
+	return append(checks, tempoCredentialCheck(ctx, tempoClient))
+}
+
+// jiraCredentialChecks fetches the current Jira user and compares it with whoAmI. The user is nil
+// if the credentials do not work.
+func jiraCredentialChecks(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient) ([]config.Check, *model.User) {
+	jiraCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	user, err := jiraClient.FetchCurrentUserContext(jiraCtx)
+	cancel()
+	jiraCheck := config.Check{Name: "Jira credentials", Err: err, Hint: credentialHint(err, "Jira")}
+	if err != nil {
+		return []config.Check{jiraCheck}, nil
+	}
+	jiraCheck.Detail = fmt.Sprintf("authenticated as %s (%s)", user.DisplayName, user.AccountID)
+
+	// Cloud reads the account from the credentials, so an unset whoAmI is only informational
+	accountCheck := config.Check{Name: "Account ID", Detail: cfg.GetWhoAmI()}
+	switch whoAmI := cfg.GetWhoAmI(); {
+	case whoAmI == "" || whoAmI == config.DefaultWhoAmI:
+		accountCheck.Detail = fmt.Sprintf("whoAmI not set, using %s from the credentials", user.AccountID)
+	case whoAmI != user.AccountID:
+		accountCheck.Err = fmt.Errorf("whoAmI is %q but the credentials belong to %q", whoAmI, user.AccountID)
+		accountCheck.Hint = fmt.Sprintf("Run 'jira-report config set whoAmI %s'", user.AccountID)
+	}
+	return []config.Check{jiraCheck, accountCheck}, user
+}
+
+// tempoCredentialCheck fetches the Tempo work attributes to prove the Tempo credentials work
+func tempoCredentialCheck(ctx context.Context, tempoClient *api.TempoClient) config.Check {
+	tempoCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	attributes, err := tempoClient.FetchWorkAttributesContext(tempoCtx)
+	cancel()
+	tempoCheck := config.Check{Name: "Tempo credentials", Err: err, Hint: credentialHint(err, "Tempo")}
+	if err == nil {
+		tempoCheck.Detail = fmt.Sprintf("%d work attributes", len(attributes))
+	}
+	return tempoCheck
+}
+
+// credentialHint suggests how to fix a failed Jira or Tempo request
+func credentialHint(err error, service string) string {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, api.ErrUnauthorized):
+		if service == "Tempo" {
+			return "Create a new token under Tempo > Settings > API Integration and run 'jira-report config set tempoApiToken <token>'"
+		}
+		return "Create a new API token at https://id.atlassian.com/manage-profile/security/api-tokens and run 'jira-report config set apiToken <token>', or run 'jira-report auth login'"
+	case errors.Is(err, api.ErrNotFound):
+		return fmt.Sprintf("Check jiraServer and deployment; %s was not found at the configured address", service)
+	case errors.Is(err, api.ErrRateLimited):
+		return "Rate limited; try again in a minute"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "The request timed out; check your network, proxy (HTTPS_PROXY) and VPN"
+	}
+	return "Check your network connection and the jiraServer setting"
+}
+
+// allPassed reports whether every check succeeded
+func allPassed(checks []config.Check) bool {
+	for _, check := range checks {
+		if !check.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// printChecks prints a line per check, with hints for failures, and reports whether all passed
+func printChecks(checks []config.Check) bool {
+	for _, check := range checks {
+		if check.Passed() {
+			if check.Detail != "" {
+				fmt.Printf("✓ %s: %s\n", check.Name, check.Detail)
+			} else {
+				fmt.Printf("✓ %s\n", check.Name)
+			}
+			continue
+		}
+		fmt.Printf("✗ %s: %v\n", check.Name, check.Err)
+		if check.Hint != "" {
+			fmt.Printf("    → %s\n", check.Hint)
+		}
+	}
+	return allPassed(checks)
+}
+
+func init() {
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configValidateCmd)
+}
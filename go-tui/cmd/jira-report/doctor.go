@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+	"golang.org/x/term"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/config"
+)
+
+// requiredPermissions are the Jira project permissions the app needs
+var requiredPermissions = []string{"BROWSE_PROJECTS", "WORK_ON_ISSUES"}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose configuration, credentials, connectivity and terminal problems",
+	Long: `Check config resolution (file, profile and environment), the keyring, the OAuth
+token, the Jira site, Jira and Tempo reachability and permissions, clipboard support
+and terminal capabilities. Secrets are redacted, so the report can be attached to a
+bug ticket. Exits with status 1 if any check fails.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		ok := true
+
+		fmt.Println("🩺 Jira Daily Report - Doctor")
+		fmt.Println("=============================")
+		fmt.Printf("Date:     %s\n", time.Now().Format(time.RFC3339))
+		fmt.Printf("System:   %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+		fmt.Printf("Profile:  %s\n", config.ActiveProfile())
+
+		printSection("Configuration")
+		schema := config.ValidateSchema()
+		ok = printChecks(schema) && ok
+		if sources, err := config.SettingSources(); err == nil {
+			fmt.Println()
+			for _, source := range sources {
+				value := source.Value
+				if value == "" {
+					value = "-"
+				}
+				fmt.Printf("  %-14s %-40s %s\n", source.Key, value, source.Source)
+			}
+		}
+
+		printSection("Secrets")
+		ok = printChecks(keyringChecks()) && ok
+
+		printSection("OAuth")
+		ok = printChecks(oauthTokenChecks()) && ok
+
+		printSection("Connectivity")
+		if allPassed(schema) {
+			ok = printChecks(connectionChecks(ctx)) && ok
+		} else {
+			fmt.Println("Skipped: fix the configuration first")
+		}
+
+		printSection("Environment")
+		ok = printChecks(environmentChecks()) && ok
+
+		if !ok {
+			os.Exit(1)
+		}
+	},
+}
+
+// printSection prints a report section heading
+func printSection(title string) {
+	fmt.Printf("\n%s\n%s\n", title, strings.Repeat("-", len(title)))
+}
+
+// keyringChecks checks where secrets are stored
+func keyringChecks() []config.Check {
+	check := config.Check{Name: "OS keyring", Detail: "available"}
+	if err := config.ProbeKeyring(); err != nil {
+		check.Err = err
+		check.Hint = "API tokens fall back to a secrets file that is only obfuscated unless JIRA_REPORT_SECRETS_KEY is set; on headless Linux start a Secret Service (e.g. gnome-keyring) or set JIRA_REPORT_SECRETS_KEY to a long random value"
+	}
+	return []config.Check{check}
+}
+
+// oauthTokenChecks inspects the OAuth token of the active profile without using or deleting it
+func oauthTokenChecks() []config.Check {
+	store := config.TokenStore()
+	info, err := store.Inspect()
+	if err != nil {
+		return []config.Check{{Name: "OAuth token", Err: err, Hint: "Run 'jira-report auth logout' and 'jira-report auth login'"}}
+	}
+	if info == nil {
+		return []config.Check{{Name: "OAuth token", Detail: "none (API tokens are used)"}}
+	}
+
+	tokenCheck := config.Check{Name: "OAuth token", Detail: fmt.Sprintf("in %s, created %s", info.Backend, info.CreatedAt.Format(time.RFC3339))}
+
+	expiryCheck := config.Check{Name: "Token expiry"}
+	switch {
+	case info.Expiry.IsZero():
+		expiryCheck.Detail = "never"
+	case time.Now().Before(info.Expiry):
+		expiryCheck.Detail = fmt.Sprintf("%s (in %s)", info.Expiry.Format(time.RFC3339), time.Until(info.Expiry).Round(time.Minute))
+	case info.HasRefresh:
+		expiryCheck.Detail = fmt.Sprintf("expired %s, will be refreshed", info.Expiry.Format(time.RFC3339))
+	default:
+		expiryCheck.Err = fmt.Errorf("expired %s and no refresh token is stored", info.Expiry.Format(time.RFC3339))
+		expiryCheck.Hint = "Run 'jira-report auth login'"
+	}
+
+	deviceCheck := config.Check{Name: "Device hash", Detail: fmt.Sprintf("%s (matches this device)", store.DeviceHash())}
+	if !info.DeviceMatches {
+		deviceCheck.Err = fmt.Errorf("token is bound to device %s, this device is %s", info.DeviceHash, store.DeviceHash())
+		deviceCheck.Hint = "The hostname or user changed since login; run 'jira-report auth login'"
+	}
+
+	return []config.Check{tokenCheck, expiryCheck, deviceCheck}
+}
+
+// connectionChecks resolves the Jira site and checks reachability, credentials and permissions.
+// Unlike other commands it does not cache the cloud ID, so the config file is left as it is.
+func connectionChecks(ctx context.Context) []config.Check {
+	cfg, err := config.NewManager()
+	if err != nil {
+		return []config.Check{{Name: "Configuration", Err: err, Hint: "Run 'jira-report config validate'"}}
+	}
+
+	checks := []config.Check{reachabilityCheck(ctx, cfg.GetJiraServer())}
+
+	var siteCheck *config.Check
+	lookupCloudID := func(ctx context.Context, source oauth2.TokenSource) (string, error) {
+		site, cached, err := cfg.LookupOAuthSite(ctx, source)
+		if err != nil {
+			return "", err
+		}
+		siteCheck = &config.Check{Name: "Cloud ID", Detail: fmt.Sprintf("%s (looked up for %s)", site.CloudID, site.URL)}
+		if cached {
+			siteCheck.Detail = fmt.Sprintf("%s (cached for %s)", site.CloudID, site.URL)
+		}
+		return site.CloudID, nil
+	}
+
+	jiraClient, tempoClient, err := buildAPIClientsWith(ctx, cfg, lookupCloudID)
+	if err != nil {
+		return append(checks, config.Check{Name: "Cloud ID", Err: err, Hint: "Choose the site with 'jira-report auth sites select'"})
+	}
+	if siteCheck != nil {
+		checks = append(checks, *siteCheck)
+	}
+	return append(checks, apiChecks(ctx, cfg, jiraClient, tempoClient)...)
+}
+
+// apiChecks checks the credentials and the permissions needed to read issues and log work
+func apiChecks(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) []config.Check {
+	checks, user := jiraCredentialChecks(ctx, cfg, jiraClient)
+	tempoCheck := tempoCredentialCheck(ctx, tempoClient)
+	checks = append(checks, tempoCheck)
+	if user == nil {
+		return checks
+	}
+
+	permissionCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	granted, err := jiraClient.FetchMyPermissionsContext(permissionCtx, requiredPermissions...)
+	cancel()
+	permissionCheck := config.Check{Name: "Jira permissions", Detail: strings.Join(requiredPermissions, ", "), Err: err, Hint: credentialHint(err, "Jira")}
+	if err == nil {
+		var missing []string
+		for _, permission := range requiredPermissions {
+			if !granted[permission] {
+				missing = append(missing, permission)
+			}
+		}
+		if len(missing) > 0 {
+			permissionCheck.Err = fmt.Errorf("missing %s", strings.Join(missing, ", "))
+			permissionCheck.Hint = "Ask a Jira administrator for these project permissions"
+		}
+	}
+	checks = append(checks, permissionCheck)
+	if !tempoCheck.Passed() {
+		return checks
+	}
+
+	today := time.Now().Format("2006-01-02")
+	worklogCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	worklogs, err := tempoClient.FetchWorklogsContext(worklogCtx, user.AccountID, today, today)
+	cancel()
+	worklogCheck := config.Check{Name: "Tempo worklogs", Err: err, Hint: credentialHint(err, "Tempo")}
+	if err == nil {
+		worklogCheck.Detail = fmt.Sprintf("%d today", len(worklogs))
+	}
+	return append(checks, worklogCheck)
+}
+
+// reachabilityCheck checks the Jira server answers over HTTP, without credentials
+func reachabilityCheck(ctx context.Context, server string) config.Check {
+	check := config.Check{Name: "Jira reachable"}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(server, "/")+"/status", nil)
+	if err != nil {
+		check.Err = err
+		check.Hint = "Check the jiraServer setting"
+		return check
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		check.Err = err
+		check.Hint = "Check your network connection, DNS, proxy (HTTPS_PROXY) and VPN"
+		return check
+	}
+	resp.Body.Close()
+	check.Detail = fmt.Sprintf("%s in %s", resp.Status, time.Since(start).Round(time.Millisecond))
+	if resp.StatusCode >= 500 {
+		check.Err = fmt.Errorf("server answered %s", resp.Status)
+		check.Hint = "Jira may be down; check https://status.atlassian.com for Cloud"
+	}
+	return check
+}
+
+// environmentChecks checks clipboard support and terminal capabilities
+func environmentChecks() []config.Check {
+	clipboardCheck := config.Check{Name: "Clipboard", Detail: "supported"}
+	if clipboard.Unsupported {
+		clipboardCheck.Err = fmt.Errorf("no clipboard tool found")
+		clipboardCheck.Hint = "Install xclip, xsel or wl-clipboard (Wayland)"
+	}
+
+	terminalCheck := config.Check{Name: "Terminal"}
+	fd := int(os.Stdout.Fd())
+	if term.IsTerminal(fd) {
+		width, height, _ := term.GetSize(fd)
+		terminalCheck.Detail = fmt.Sprintf("%dx%d, TERM=%s, colors=%s", width, height, os.Getenv("TERM"), lipgloss.ColorProfile().Name())
+		if width < 80 || height < 24 {
+			terminalCheck.Err = fmt.Errorf("%dx%d is smaller than 80x24", width, height)
+			terminalCheck.Hint = "Enlarge the window; the TUI panels need at least 80 columns and 24 rows"
+		}
+	} else {
+		terminalCheck.Detail = fmt.Sprintf("not a terminal (output redirected), TERM=%s", os.Getenv("TERM"))
+	}
+
+	localeCheck := config.Check{Name: "UTF-8 locale"}
+	locale := firstNonEmpty(os.Getenv("LC_ALL"), os.Getenv("LC_CTYPE"), os.Getenv("LANG"))
+	localeCheck.Detail = locale
+	if runtime.GOOS != "windows" && !strings.Contains(strings.ToUpper(locale), "UTF-8") && !strings.Contains(strings.ToUpper(locale), "UTF8") {
+		localeCheck.Err = fmt.Errorf("locale %q is not UTF-8", locale)
+		localeCheck.Hint = "Set LANG=en_US.UTF-8 (or another UTF-8 locale) so icons and borders render"
+	}
+
+	return []config.Check{clipboardCheck, terminalCheck, localeCheck}
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
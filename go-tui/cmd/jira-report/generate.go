@@ -1,97 +1,241 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/spf13/cobra"
 	"github.com/yourusername/jira-daily-report/internal/api"
 	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
 	"github.com/yourusername/jira-daily-report/internal/report"
+	"golang.org/x/oauth2"
 )
 
 var (
-	outputFile    string
-	outputFormat  string
-	copyClipboard bool
-	silent        bool
+	outputFile           string
+	outputFormat         string
+	templateFile         string
+	printDefaultTemplate bool
+	copyClipboard        bool
+	silent               bool
+	sinceDate            string
+	untilDate            string
+	lastWeek             bool
 )
 
 var generateCmd = &cobra.Command{
 	Use:   "generate",
 	Short: "Generate daily standup report",
-	Long:  `Generate a daily standup report from your Jira tasks and Tempo worklogs.`,
+	Long: `Generate a daily standup report from your Jira tasks and Tempo worklogs.
+
+Use --since (and optionally --until) to summarize worklogs over a date range
+instead, grouped by day and by epic.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		if sinceDate != "" || untilDate != "" {
+			runSummary(ctx, sinceDate, untilDate)
+			return
+		}
+
+		if printDefaultTemplate {
+			fmt.Print(report.DefaultTemplate)
+			return
+		}
+
+		format, err := report.ParseFormat(outputFormat)
+		if err != nil {
+			log.Fatal(err)
+		}
+
 		// Load configuration
 		cfg, err := config.NewManager()
 		if err != nil {
 			log.Fatalf("Failed to load configuration: %v", err)
 		}
 
+		// Template flag overrides the configured template
+		if templateFile == "" {
+			templateFile = cfg.GetReportTemplate()
+		}
+		renderer, err := report.NewRendererWithTemplate(format, templateFile)
+		if err != nil {
+			log.Fatalf("Failed to load report template: %v", err)
+		}
+
 		if !silent {
 			fmt.Println("Generating daily report...")
 		}
 
-		// Initialize clients - prefer OAuth if available
-		var jiraClient *api.JiraClient
-		if oauthToken := cfg.GetOAuthToken(); oauthToken != "" {
-			jiraClient = api.NewOAuthJiraClient(cfg.GetJiraServer(), oauthToken)
-		} else {
-			jiraClient = api.NewJiraClient(
-				cfg.GetJiraServer(),
-				cfg.GetUsername(),
-				cfg.GetApiToken(),
-			)
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		// Generate report
+		reportData, err := report.FetchDailyReport(ctx, cfg, jiraClient, tempoClient)
+		if err != nil {
+			log.Fatalf("Failed to generate report: %v", err)
+		}
+
+		reportContent, err := renderer.Render(reportData)
+		if err != nil {
+			log.Fatalf("Failed to render report: %v", err)
+		}
+
+		writeReport(cfg, reportContent)
+	},
+}
+
+var generateWeeklyCmd = &cobra.Command{
+	Use:   "weekly",
+	Short: "Summarize this week's worklogs",
+	Long: `Summarize worklogs from Monday of the current week until today,
+grouped by day and by epic. Use --last-week for the previous Monday-Sunday.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		start := dateutil.WeekStart(time.Now())
+		end := time.Now()
+		if lastWeek {
+			end = start.AddDate(0, 0, -1)
+			start = start.AddDate(0, 0, -7)
 		}
+		runSummary(ctx, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	},
+}
+
+// runSummary generates and outputs a date-range summary; empty bounds default to today
+func runSummary(ctx context.Context, since, until string) {
+	format, err := report.ParseFormat(outputFormat)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		tempoClient := api.NewTempoClient(
-			cfg.GetTempoApiToken(),
-			jiraClient,
+	from, err := dateutil.ParseWorklogDate(since)
+	if err != nil {
+		log.Fatalf("Invalid --since date: %v", err)
+	}
+	to, err := dateutil.ParseWorklogDate(until)
+	if err != nil {
+		log.Fatalf("Invalid --until date: %v", err)
+	}
+
+	cfg, err := config.NewManager()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	if !silent {
+		fmt.Printf("Generating summary for %s to %s...\n", from, to)
+	}
+
+	jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+	summary, err := report.FetchSummary(ctx, cfg, jiraClient, tempoClient, from, to)
+	if err != nil {
+		log.Fatalf("Failed to generate summary: %v", err)
+	}
+
+	content, err := report.RenderSummary(summary, format)
+	if err != nil {
+		log.Fatalf("Failed to render summary: %v", err)
+	}
+
+	writeReport(cfg, content)
+}
+
+// newAPIClients initializes the Jira and Tempo clients - prefer OAuth if available
+func newAPIClients(ctx context.Context, cfg *config.Manager) (*api.JiraClient, *api.TempoClient) {
+	jiraClient, tempoClient, err := buildAPIClients(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to resolve the Jira site: %v", err)
+	}
+	return jiraClient, tempoClient
+}
+
+// buildAPIClients is like newAPIClients but returns an error when the OAuth site cannot be resolved
+func buildAPIClients(ctx context.Context, cfg *config.Manager) (*api.JiraClient, *api.TempoClient, error) {
+	return buildAPIClientsWith(ctx, cfg, cfg.ResolveCloudID)
+}
+
+// buildAPIClientsWith is like buildAPIClients but resolves the OAuth cloud ID with resolveCloudID
+func buildAPIClientsWith(ctx context.Context, cfg *config.Manager, resolveCloudID func(context.Context, oauth2.TokenSource) (string, error)) (*api.JiraClient, *api.TempoClient, error) {
+	// Server/Data Center: REST v2 with a personal access token, and Tempo inside Jira
+	if cfg.GetDeployment() == model.DeploymentServer {
+		jiraClient := api.NewServerJiraClient(cfg.GetJiraServer(), cfg.GetApiToken())
+		jiraClient.SetFlaggedField(cfg.GetFlaggedField())
+		return jiraClient, api.NewTempoServerClient(jiraClient), nil
+	}
+
+	var jiraClient *api.JiraClient
+	if tokenSource := cfg.GetOAuthTokenSource(); tokenSource != nil {
+		cloudID, err := resolveCloudID(ctx, tokenSource)
+		if err != nil {
+			return nil, nil, err
+		}
+		jiraClient = api.NewOAuthJiraClient(cloudID, tokenSource)
+	} else {
+		jiraClient = api.NewJiraClient(
+			cfg.GetJiraServer(),
+			cfg.GetUsername(),
+			cfg.GetApiToken(),
 		)
+	}
 
-		// Generate report
-		reportContent, err := report.GenerateDailyReport(cfg, jiraClient, tempoClient)
+	jiraClient.SetFlaggedField(cfg.GetFlaggedField())
+
+	tempoClient := api.NewTempoClient(
+		cfg.GetTempoApiToken(),
+		jiraClient,
+	)
+	return jiraClient, tempoClient, nil
+}
+
+// writeReport writes rendered content to the output file or stdout and optionally the clipboard
+func writeReport(cfg *config.Manager, reportContent string) {
+	// Handle output
+	if outputFile != "" {
+		err := os.WriteFile(outputFile, []byte(reportContent), 0644)
 		if err != nil {
-			log.Fatalf("Failed to generate report: %v", err)
+			log.Fatalf("Failed to write output file: %v", err)
+		}
+		if !silent {
+			fmt.Printf("Report written to %s\n", outputFile)
 		}
+	} else {
+		// Print to stdout
+		fmt.Println(reportContent)
+	}
 
-		// Handle output
-		if outputFile != "" {
-			err := os.WriteFile(outputFile, []byte(reportContent), 0644)
-			if err != nil {
-				log.Fatalf("Failed to write output file: %v", err)
-			}
+	// Handle clipboard
+	if copyClipboard || cfg.GetAutoClipboard() {
+		err := clipboard.WriteAll(reportContent)
+		if err != nil {
 			if !silent {
-				fmt.Printf("Report written to %s\n", outputFile)
+				fmt.Printf("Warning: Failed to copy to clipboard: %v\n", err)
 			}
 		} else {
-			// Print to stdout
-			fmt.Println(reportContent)
-		}
-
-		// Handle clipboard
-		if copyClipboard || cfg.GetAutoClipboard() {
-			err := clipboard.WriteAll(reportContent)
-			if err != nil {
-				if !silent {
-					fmt.Printf("Warning: Failed to copy to clipboard: %v\n", err)
-				}
-			} else {
-				if !silent {
-					fmt.Println("Report copied to clipboard!")
-				}
+			if !silent {
+				fmt.Println("Report copied to clipboard!")
 			}
 		}
-	},
+	}
 }
 
 func init() {
-	generateCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file path")
-	generateCmd.Flags().StringVarP(&outputFormat, "format", "f", "text", "Output format (text currently supported)")
-	generateCmd.Flags().BoolVarP(&copyClipboard, "clipboard", "c", false, "Copy report to clipboard")
-	generateCmd.Flags().BoolVarP(&silent, "silent", "s", false, "Suppress info messages")
+	generateCmd.PersistentFlags().StringVarP(&outputFile, "output", "o", "", "Output file path")
+	generateCmd.PersistentFlags().StringVarP(&outputFormat, "format", "f", "text", "Output format ("+report.FormatHelp+")")
+	generateCmd.Flags().StringVarP(&templateFile, "template", "t", "", "Path to a text/template file for the text format (overrides reportTemplate in config)")
+	generateCmd.Flags().BoolVar(&printDefaultTemplate, "print-default-template", false, "Print the built-in report template and exit")
+	generateCmd.PersistentFlags().BoolVarP(&copyClipboard, "clipboard", "c", false, "Copy report to clipboard")
+	generateCmd.PersistentFlags().BoolVarP(&silent, "silent", "s", false, "Suppress info messages")
+	generateCmd.Flags().StringVar(&sinceDate, "since", "", "Summarize worklogs from this date ("+dateutil.WorklogDateFormatHelp+")")
+	generateCmd.Flags().StringVar(&untilDate, "until", "", "Summarize worklogs up to this date, inclusive (default today)")
+
+	generateWeeklyCmd.Flags().BoolVar(&lastWeek, "last-week", false, "Summarize the previous Monday-Sunday instead of this week")
 
+	generateCmd.AddCommand(generateWeeklyCmd)
 	rootCmd.AddCommand(generateCmd)
 }
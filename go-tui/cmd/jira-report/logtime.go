@@ -1,22 +1,30 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"regexp"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/yourusername/jira-daily-report/internal/api"
 	"github.com/yourusername/jira-daily-report/internal/config"
 	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
 var (
-	date          string
-	description   string
-	logtimeSilent bool
+	date            string
+	description     string
+	logtimeSilent   bool
+	logtimeDryRun   bool
+	logtimeAtomic   bool
+	logtimeStart    string
+	logtimeBillable string
+	logtimeAttrs    []string
 )
 
 type timeEntry struct {
@@ -28,9 +36,17 @@ var logtimeCmd = &cobra.Command{
 	Use:   "logtime <entries>",
 	Short: "Log time to Tempo",
 	Long: `Log time to Tempo using a simple format: "KEY-123 2h, KEY-456 1.5h". 
-Separated by comma. Supported units: h, m.`,
+Separated by comma. Supported units: h, m.
+
+Use --start to set the start time of the first entry (later entries follow on
+from it), --billable to set billable time per entry ("0" for non-billable) and
+--attr key=value (repeatable) to set Tempo work attributes such as Work Type.
+
+Use --dry-run to check the entries without logging anything, and --atomic to
+delete the worklogs already created in the batch if a later entry fails.`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
 		entriesStr := args[0]
 
 		// Load configuration
@@ -44,101 +60,246 @@ Separated by comma. Supported units: h, m.`,
 		}
 
 		// Initialize clients - prefer OAuth if available
-		var jiraClient *api.JiraClient
-		if oauthToken := cfg.GetOAuthToken(); oauthToken != "" {
-			jiraClient = api.NewOAuthJiraClient(cfg.GetJiraServer(), oauthToken)
-		} else {
-			jiraClient = api.NewJiraClient(
-				cfg.GetJiraServer(),
-				cfg.GetUsername(),
-				cfg.GetApiToken(),
-			)
-		}
-
-		tempoClient := api.NewTempoClient(
-			cfg.GetTempoApiToken(),
-			jiraClient,
-		)
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
 
-		// Fetch user to get account ID
-		user, err := jiraClient.FetchCurrentUser()
+		targetDate, err := dateutil.ParseWorklogDate(date)
 		if err != nil {
-			log.Fatalf("Failed to fetch user info: %v", err)
+			log.Fatal(err)
 		}
 
-		targetDate, err := dateutil.ParseWorklogDate(date)
+		extras, err := parseWorklogExtras()
 		if err != nil {
 			log.Fatal(err)
 		}
+		if defs := fetchWorkAttributes(ctx, tempoClient, logtimeSilent); defs != nil {
+			extras.attributes, err = model.ValidateWorkAttributes(defs, extras.attributes)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
 
 		// Parse entries
 		// Format: "KEY-123 2h, KEY-456 1.5h"
-		// Regex to find chunks
 		entryList := parseEntries(entriesStr)
 		if len(entryList) == 0 {
 			log.Fatal("No valid entries found. Format example: 'KEY-123 2h, KEY-456 30m'")
 		}
 
-		// Execute logs
-		successCount := 0
+		// Resolve durations and issue keys before anything is logged
+		var resolved []resolvedEntry
 		for _, entry := range entryList {
-			// Reuse LogTimeAction logic for parsing time string to seconds?
-			// actions package has LogTimeAction but it takes "2h" string and parses inside?
-			// Let's check `actions.NewLogTimeAction`...
-			// It converts timeValue string to int seconds inside `Execute`? No, let's look at `log_time_action.go`.
-			// Ah, `actions.NewLogTimeAction` takes `timeValue string`.
-			// `Execute` does `ParseTimeInput(a.timeValue)`.
-			// So we can reuse that logic if we duplicate it or make it public.
-			// Ideally we move `ParseTimeInput` to a shared helper.
-			// For now, let's reimplement simple parsing or use `actions` if possible.
-			// But `actions` depends on `state` and `tea`.
-
-			// Simple parse here for CLI independence
-			seconds, err := parseDuration(entry.duration)
+			r, err := resolveEntry(ctx, jiraClient, entry)
 			if err != nil {
-				fmt.Printf("❌ Failed to parse duration for %s: %v\n", entry.key, err)
+				fmt.Printf("❌ %v\n", err)
 				continue
 			}
+			resolved = append(resolved, r)
+		}
 
-			if !logtimeSilent {
-				fmt.Printf("⏳ Logging %s to %s...\n", entry.duration, entry.key)
+		if logtimeDryRun {
+			fmt.Printf("\nWould log on %s:\n", targetDate)
+			total := 0
+			startTime := extras.startTime
+			for _, r := range resolved {
+				fmt.Printf("  %-8s %-12s %7s  %s\n", formatStartTime(startTime), r.key, formatWorklogDuration(r.seconds), r.summary)
+				startTime = advanceStartTime(startTime, r.seconds)
+				total += r.seconds
 			}
-
-			// Need to resolve Issue Key to Issue ID
-			issue, err := jiraClient.FetchIssue(entry.key)
-			if err != nil {
-				fmt.Printf("❌ Failed to find issue %s: %v\n", entry.key, err)
-				continue
+			if extras.billableSeconds != nil {
+				fmt.Printf("\nBillable per entry: %s\n", formatWorklogDuration(*extras.billableSeconds))
+			}
+			for _, attr := range extras.attributes {
+				fmt.Printf("Attribute: %s = %s\n", attr.Key, attr.Value)
 			}
+			fmt.Printf("\nDry run: %d/%d entries valid, %s total. Nothing was logged.\n", len(resolved), len(entryList), formatWorklogDuration(total))
+			if len(resolved) < len(entryList) {
+				os.Exit(1)
+			}
+			return
+		}
 
-			issueID, _ := strconv.Atoi(issue.ID)
+		if logtimeAtomic && len(resolved) < len(entryList) {
+			log.Fatal("Nothing was logged: fix the entries above or run without --atomic")
+		}
 
-			// Fetch user to get account ID if not already fetched
-			// But wait, we need user account ID for each call?
-			// Actually we can fetch user once outside the loop?
-			// Yes, fetch user once BEFORE loop.
-			// But wait, `logtime.go` defines `user` inside?
-			// No, previous edit was inside loop. Let's do it properly.
-			// CreateWorklog(issueID, seconds, startDate, description, authorAccountID)
+		// Fetch user to get account ID
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
 
-			// We need `user` variable.
-			// Let's assume we fetch user before loop.
+		// Execute logs
+		var created []resolvedEntry
+		startTime := extras.startTime
+		for _, r := range resolved {
+			if !logtimeSilent {
+				fmt.Printf("⏳ Logging %s to %s...\n", r.duration, r.key)
+			}
 
-			_, err = tempoClient.CreateWorklog(issueID, seconds, targetDate, description, user.AccountID)
+			request := model.WorklogRequest{
+				IssueID:          r.issueID,
+				TimeSpentSeconds: r.seconds,
+				StartDate:        targetDate,
+				StartTime:        startTime,
+				Description:      description,
+				AuthorAccountID:  user.AccountID,
+				BillableSeconds:  extras.billableSeconds,
+				Attributes:       extras.attributes,
+			}
+			sent := time.Now()
+			worklog, err := tempoClient.CreateWorklogRequestContext(ctx, request)
+			startTime = advanceStartTime(startTime, r.seconds)
+			if err != nil && !logtimeAtomic && queueOfflineWorklog(r.key, request, sent, err) {
+				continue
+			}
 			if err != nil {
-				fmt.Printf("❌ Failed to log time for %s: %v\n", entry.key, err)
-			} else {
-				fmt.Printf("✓ Logged %s to %s\n", entry.duration, entry.key)
-				successCount++
+				fmt.Printf("❌ Failed to log time for %s: %v\n", r.key, err)
+				if logtimeAtomic {
+					// Roll back even after Ctrl-C cancelled the batch
+					rollbackWorklogs(context.WithoutCancel(ctx), tempoClient, created)
+					os.Exit(1)
+				}
+				if ctx.Err() != nil {
+					fmt.Println("Interrupted: the remaining entries were not logged.")
+					break
+				}
+				continue
 			}
+
+			fmt.Printf("✓ Logged %s to %s\n", r.duration, r.key)
+			r.worklogID = worklog.TempoWorklogID
+			created = append(created, r)
 		}
 
 		if !logtimeSilent {
-			fmt.Printf("\nSuccessfully logged %d/%d entries.\n", successCount, len(entryList))
+			fmt.Printf("\nSuccessfully logged %d/%d entries.\n", len(created), len(entryList))
 		}
 	},
 }
 
+// worklogExtras are the optional Tempo fields applied to every logged entry
+type worklogExtras struct {
+	startTime       string // HH:MM:SS of the first entry
+	billableSeconds *int
+	attributes      []model.WorkAttributeValue
+}
+
+// parseWorklogExtras reads the --start, --billable and --attr flags
+func parseWorklogExtras() (worklogExtras, error) {
+	var extras worklogExtras
+	if logtimeStart != "" {
+		startTime, err := dateutil.ParseStartTime(logtimeStart)
+		if err != nil {
+			return extras, err
+		}
+		extras.startTime = startTime
+	}
+	if logtimeBillable != "" {
+		seconds, err := dateutil.ParseDuration(logtimeBillable)
+		if err != nil || seconds < 0 {
+			return extras, fmt.Errorf("invalid --billable %q (e.g. 1h30m, or 0 for non-billable)", logtimeBillable)
+		}
+		extras.billableSeconds = &seconds
+	}
+	attributes, err := model.ParseWorkAttributeValues(logtimeAttrs)
+	if err != nil {
+		return extras, err
+	}
+	extras.attributes = attributes
+	return extras, nil
+}
+
+// fetchWorkAttributes returns Tempo's configured work attributes, or nil if they cannot be
+// fetched (e.g. missing permission), in which case attribute values are sent unchecked
+func fetchWorkAttributes(ctx context.Context, tempoClient *api.TempoClient, silent bool) []model.WorkAttribute {
+	defs, err := tempoClient.FetchWorkAttributesContext(ctx)
+	if err != nil {
+		if !silent {
+			fmt.Printf("⚠ Could not load work attributes, skipping validation: %v\n", err)
+		}
+		return nil
+	}
+	return defs
+}
+
+// advanceStartTime returns the start time following an entry of seconds, empty if unset
+func advanceStartTime(startTime string, seconds int) string {
+	t, err := time.Parse("15:04:05", startTime)
+	if err != nil {
+		return startTime
+	}
+	next := t.Add(time.Duration(seconds) * time.Second)
+	if next.Day() != t.Day() {
+		return startTime // Don't wrap past midnight
+	}
+	return next.Format("15:04:05")
+}
+
+// formatStartTime formats a HH:MM:SS start time as HH:MM, or "-" when unset
+func formatStartTime(startTime string) string {
+	if len(startTime) < 5 {
+		return "-"
+	}
+	return startTime[:5]
+}
+
+// resolvedEntry is a time entry with its duration parsed and issue key looked up
+type resolvedEntry struct {
+	timeEntry
+	issueID   int
+	summary   string
+	seconds   int
+	worklogID int // Set once the worklog is created
+}
+
+// resolveEntry parses an entry's duration and resolves its issue key to an ID
+func resolveEntry(ctx context.Context, jiraClient *api.JiraClient, entry timeEntry) (resolvedEntry, error) {
+	seconds, err := dateutil.ParseDuration(entry.duration)
+	if err != nil || seconds <= 0 {
+		return resolvedEntry{}, fmt.Errorf("invalid duration %q for %s", entry.duration, entry.key)
+	}
+
+	issue, err := jiraClient.FetchIssueContext(ctx, entry.key)
+	if err != nil {
+		return resolvedEntry{}, fmt.Errorf("failed to find issue %s: %w", entry.key, err)
+	}
+	issueID, err := strconv.Atoi(issue.ID)
+	if err != nil {
+		return resolvedEntry{}, fmt.Errorf("unexpected issue ID %q for %s", issue.ID, entry.key)
+	}
+
+	return resolvedEntry{
+		timeEntry: entry,
+		issueID:   issueID,
+		summary:   issue.Fields.Summary,
+		seconds:   seconds,
+	}, nil
+}
+
+// rollbackWorklogs deletes the worklogs created earlier in an --atomic batch, newest first
+func rollbackWorklogs(ctx context.Context, tempoClient *api.TempoClient, created []resolvedEntry) {
+	if len(created) == 0 {
+		fmt.Println("Nothing was logged.")
+		return
+	}
+
+	fmt.Printf("↩ Rolling back %d worklogs...\n", len(created))
+	var leftover []string
+	for i := len(created) - 1; i >= 0; i-- {
+		r := created[i]
+		if err := tempoClient.DeleteWorklogContext(ctx, r.worklogID); err != nil {
+			fmt.Printf("❌ Failed to delete worklog %d (%s): %v\n", r.worklogID, r.key, err)
+			leftover = append(leftover, strconv.Itoa(r.worklogID))
+			continue
+		}
+		fmt.Printf("✓ Deleted worklog %d (%s)\n", r.worklogID, r.key)
+	}
+
+	if len(leftover) > 0 {
+		fmt.Printf("Rollback incomplete; delete these worklogs manually: %s\n", strings.Join(leftover, ", "))
+	}
+}
+
 func parseEntries(input string) []timeEntry {
 	var entries []timeEntry
 	parts := strings.Split(input, ",")
@@ -161,46 +322,15 @@ func parseEntries(input string) []timeEntry {
 	return entries
 }
 
-func parseDuration(input string) (int, error) {
-	// Basic parser for "1.5h", "2h", "30m", "1h 30m"
-	// Normalize
-	input = strings.ToLower(strings.ReplaceAll(input, " ", ""))
-
-	totalSeconds := 0
-
-	// Regex for component parsing
-	re := regexp.MustCompile(`(\d+(\.\d+)?)([hm])`)
-	matches := re.FindAllStringSubmatch(input, -1)
-
-	if len(matches) == 0 {
-		// Try parsing as simple number (hours)
-		if val, err := strconv.ParseFloat(input, 64); err == nil {
-			return int(val * 3600), nil
-		}
-		return 0, fmt.Errorf("invalid format")
-	}
-
-	for _, match := range matches {
-		val, err := strconv.ParseFloat(match[1], 64)
-		if err != nil {
-			continue
-		}
-		unit := match[3]
-
-		if unit == "h" {
-			totalSeconds += int(val * 3600)
-		} else if unit == "m" {
-			totalSeconds += int(val * 60)
-		}
-	}
-
-	return totalSeconds, nil
-}
-
 func init() {
 	logtimeCmd.Flags().StringVarP(&date, "date", "d", "today", "Date to log time ("+dateutil.WorklogDateFormatHelp+")")
 	logtimeCmd.Flags().StringVar(&description, "description", "", "Worklog description")
 	logtimeCmd.Flags().BoolVarP(&logtimeSilent, "silent", "s", false, "Suppress info messages")
+	logtimeCmd.Flags().BoolVar(&logtimeDryRun, "dry-run", false, "Resolve issues and durations and print what would be logged")
+	logtimeCmd.Flags().StringVar(&logtimeStart, "start", "", "Start time of the first entry (HH:MM)")
+	logtimeCmd.Flags().StringVar(&logtimeBillable, "billable", "", "Billable time per entry, e.g. 1h or 0 for non-billable (default: time spent)")
+	logtimeCmd.Flags().StringArrayVar(&logtimeAttrs, "attr", nil, "Work attribute as key=value, e.g. \"Work Type=Dev\" (repeatable)")
+	logtimeCmd.Flags().BoolVar(&logtimeAtomic, "atomic", false, "Log all entries or none: delete already created worklogs if one fails")
 
 	rootCmd.AddCommand(logtimeCmd)
 }
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+var (
+	copyFrom    string
+	copyTo      string
+	copyExclude []string
+	copyYes     bool
+)
+
+var logtimeCopyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy a day's worklogs to another date",
+	Long: `Recreate the worklogs of one day on another date, keeping issue, time and
+description. Useful for recurring days:
+
+  jira-report logtime copy --from yesterday --to today
+  jira-report logtime copy --from "last friday" --exclude KEY-1,KEY-2 -y`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		fromDate, err := dateutil.ParseWorklogDate(copyFrom)
+		if err != nil {
+			log.Fatalf("Invalid --from: %v", err)
+		}
+		toDate, err := dateutil.ParseWorklogDate(copyTo)
+		if err != nil {
+			log.Fatalf("Invalid --to: %v", err)
+		}
+		if fromDate == toDate {
+			log.Fatalf("--from and --to are the same day (%s)", fromDate)
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
+
+		worklogs, err := tempoClient.FetchWorklogsContext(ctx, user.AccountID, fromDate, fromDate)
+		if err != nil {
+			log.Fatalf("Failed to fetch worklogs: %v", err)
+		}
+		worklogs, err = tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, worklogs)
+		if err != nil {
+			log.Fatalf("Failed to enrich worklogs: %v", err)
+		}
+
+		worklogs = excludeWorklogs(worklogs, copyExclude)
+		if len(worklogs) == 0 {
+			fmt.Printf("No worklogs to copy on %s\n", fromDate)
+			return
+		}
+
+		total := 0
+		fmt.Printf("Worklogs on %s:\n\n", fromDate)
+		for _, w := range worklogs {
+			total += w.TimeSpentSeconds
+			fmt.Printf("  %-12s %6s  %s\n", w.Issue.Key, formatWorklogDuration(w.TimeSpentSeconds), w.Description)
+		}
+		fmt.Printf("\nTotal: %s\n", formatWorklogDuration(total))
+
+		if !copyYes && !confirmPrompt(fmt.Sprintf("Copy %d worklogs to %s?", len(worklogs), toDate)) {
+			fmt.Println("Aborted")
+			return
+		}
+
+		successCount := 0
+		for _, w := range worklogs {
+			_, err := tempoClient.CreateWorklogRequestContext(ctx, model.NewWorklogCopy(w, w.TimeSpentSeconds, toDate, user.AccountID))
+			if err != nil {
+				fmt.Printf("❌ Failed to copy %s: %v\n", w.Issue.Key, err)
+				if ctx.Err() != nil {
+					fmt.Println("Interrupted: the remaining worklogs were not copied.")
+					break
+				}
+				continue
+			}
+			fmt.Printf("✓ Logged %s to %s\n", formatWorklogDuration(w.TimeSpentSeconds), w.Issue.Key)
+			successCount++
+		}
+
+		fmt.Printf("\nCopied %d/%d worklogs to %s.\n", successCount, len(worklogs), toDate)
+	},
+}
+
+// excludeWorklogs drops worklogs whose issue key is in keys (case-insensitive)
+func excludeWorklogs(worklogs []model.Worklog, keys []string) []model.Worklog {
+	if len(keys) == 0 {
+		return worklogs
+	}
+	excluded := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		excluded[strings.ToUpper(strings.TrimSpace(key))] = true
+	}
+
+	var kept []model.Worklog
+	for _, w := range worklogs {
+		if !excluded[strings.ToUpper(w.Issue.Key)] {
+			kept = append(kept, w)
+		}
+	}
+	return kept
+}
+
+func init() {
+	logtimeCopyCmd.Flags().StringVar(&copyFrom, "from", "yesterday", "Day to copy worklogs from ("+dateutil.WorklogDateFormatHelp+")")
+	logtimeCopyCmd.Flags().StringVar(&copyTo, "to", "today", "Day to copy worklogs to ("+dateutil.WorklogDateFormatHelp+")")
+	logtimeCopyCmd.Flags().StringSliceVar(&copyExclude, "exclude", nil, "Issue keys to skip, comma separated")
+	logtimeCopyCmd.Flags().BoolVarP(&copyYes, "yes", "y", false, "Copy without asking for confirmation")
+
+	logtimeCmd.AddCommand(logtimeCopyCmd)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/worklogimport"
+)
+
+var (
+	importDate   string
+	importDryRun bool
+)
+
+var logtimeImportCmd = &cobra.Command{
+	Use:   "import <file.csv|->",
+	Short: "Log time to Tempo from a CSV file or stdin",
+	Long: `Create worklogs from a CSV file ("-" reads stdin). The first line is a header;
+"issue" and "duration" columns are required, the others are optional:
+
+  date,issue,duration,description,start,attr:_Account_
+  2026-04-01,GRAP-1,1h30m,Code review,09:00,ACC-1
+  yesterday,GRAP-2,30m,Standup,,
+
+Rows without a date use --date. Columns named "attr:<key>" set Tempo work
+attributes. Every row is validated and every issue key resolved before anything
+is written; use --dry-run to only print what would be logged.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		defaultDate, err := dateutil.ParseWorklogDate(importDate)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var input io.Reader = os.Stdin
+		if args[0] != "-" {
+			file, err := os.Open(args[0])
+			if err != nil {
+				log.Fatalf("Failed to open %s: %v", args[0], err)
+			}
+			defer file.Close()
+			input = file
+		}
+
+		rows, rowErrors, err := worklogimport.Parse(input, defaultDate)
+		if err != nil {
+			log.Fatalf("Failed to read CSV: %v", err)
+		}
+		if len(rows) == 0 && len(rowErrors) == 0 {
+			log.Fatal("No rows to import")
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		issueIDs, keyErrors := resolveIssueKeys(ctx, jiraClient, rows)
+		rowErrors = append(rowErrors, keyErrors...)
+		if defs := fetchWorkAttributes(ctx, tempoClient, false); defs != nil {
+			for i, row := range rows {
+				attributes, err := model.ValidateWorkAttributes(defs, row.Attributes)
+				if err != nil {
+					rowErrors = append(rowErrors, worklogimport.RowError{Line: row.Line, Err: err})
+					continue
+				}
+				rows[i].Attributes = attributes
+			}
+		}
+		sort.Slice(rowErrors, func(i, j int) bool { return rowErrors[i].Line < rowErrors[j].Line })
+		if len(rowErrors) > 0 {
+			fmt.Fprintf(os.Stderr, "Found %d problems, nothing was logged:\n", len(rowErrors))
+			for _, rowErr := range rowErrors {
+				fmt.Fprintf(os.Stderr, "  %v\n", rowErr)
+			}
+			os.Exit(1)
+		}
+
+		printImportTable(rows)
+		if importDryRun {
+			fmt.Printf("\nDry run: %d worklogs would be logged.\n", len(rows))
+			return
+		}
+
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
+
+		fmt.Println()
+		var failed []string
+		for _, row := range rows {
+			_, err := tempoClient.CreateWorklogRequestContext(ctx, model.WorklogRequest{
+				IssueID:          issueIDs[row.IssueKey],
+				TimeSpentSeconds: row.Seconds,
+				StartDate:        row.Date,
+				StartTime:        row.StartTime,
+				Description:      row.Description,
+				AuthorAccountID:  user.AccountID,
+				Attributes:       row.Attributes,
+			})
+			if err != nil {
+				fmt.Printf("❌ Line %d: failed to log %s to %s: %v\n", row.Line, formatWorklogDuration(row.Seconds), row.IssueKey, err)
+				failed = append(failed, strconv.Itoa(row.Line))
+				if ctx.Err() != nil {
+					fmt.Println("Interrupted: the remaining rows were not logged.")
+					break
+				}
+				continue
+			}
+			fmt.Printf("✓ Logged %s to %s on %s\n", formatWorklogDuration(row.Seconds), row.IssueKey, row.Date)
+		}
+
+		fmt.Printf("\nCreated %d/%d worklogs.\n", len(rows)-len(failed), len(rows))
+		if len(failed) > 0 {
+			fmt.Printf("Failed lines: %s\n", strings.Join(failed, ", "))
+			os.Exit(1)
+		}
+	},
+}
+
+// resolveIssueKeys looks up the Jira ID of each distinct issue key in rows
+func resolveIssueKeys(ctx context.Context, jiraClient *api.JiraClient, rows []worklogimport.Row) (map[string]int, []worklogimport.RowError) {
+	issueIDs := make(map[string]int)
+	keyErrors := make(map[string]error)
+	var rowErrors []worklogimport.RowError
+
+	for _, row := range rows {
+		if _, ok := issueIDs[row.IssueKey]; !ok && keyErrors[row.IssueKey] == nil {
+			issue, err := jiraClient.FetchIssueContext(ctx, row.IssueKey)
+			if err == nil {
+				var id int
+				id, err = strconv.Atoi(issue.ID)
+				issueIDs[row.IssueKey] = id
+			}
+			if err != nil {
+				keyErrors[row.IssueKey] = fmt.Errorf("issue %s not found: %w", row.IssueKey, err)
+			}
+		}
+		if err := keyErrors[row.IssueKey]; err != nil {
+			rowErrors = append(rowErrors, worklogimport.RowError{Line: row.Line, Err: err})
+		}
+	}
+	return issueIDs, rowErrors
+}
+
+// printImportTable prints the rows that will be logged
+func printImportTable(rows []worklogimport.Row) {
+	total := 0
+	fmt.Printf("%-5s %-10s %-8s %-12s %7s  %s\n", "LINE", "DATE", "START", "ISSUE", "TIME", "DESCRIPTION")
+	for _, row := range rows {
+		description := row.Description
+		for _, attr := range row.Attributes {
+			description += fmt.Sprintf(" [%s=%s]", attr.Key, attr.Value)
+		}
+		fmt.Printf("%-5d %-10s %-8s %-12s %7s  %s\n", row.Line, row.Date, formatStartTime(row.StartTime), row.IssueKey, formatWorklogDuration(row.Seconds), strings.TrimSpace(description))
+		total += row.Seconds
+	}
+	fmt.Printf("\nTotal: %d worklogs, %s\n", len(rows), formatWorklogDuration(total))
+}
+
+func init() {
+	logtimeImportCmd.Flags().StringVarP(&importDate, "date", "d", "today", "Date for rows without one ("+dateutil.WorklogDateFormatHelp+")")
+	logtimeImportCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Validate and print the worklogs without logging them")
+
+	logtimeCmd.AddCommand(logtimeImportCmd)
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -16,8 +18,14 @@ var rootCmd = &cobra.Command{
 	Short: "Jira Daily Report - Generate daily standup reports and manage tasks",
 	Long: `A fast, performant TUI for managing Jira tasks, 
 logging time to Tempo, and generating daily reports.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		config.SetProfile(profileName)
+	},
 }
 
+// profileName is the configuration profile chosen with --profile
+var profileName string
+
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
 	Short: "Launch the interactive terminal UI",
@@ -28,8 +36,9 @@ var tuiCmd = &cobra.Command{
 			log.Fatal("Failed to load configuration:", err)
 		}
 
-		// Create and run Bubble Tea TUI
-		model := tui.NewModel(cfg)
+		// Create and run Bubble Tea TUI - prefer OAuth if available
+		jiraClient, tempoClient := newAPIClients(cmd.Context(), cfg)
+		model := tui.NewModel(cfg, jiraClient, tempoClient)
 		p := tea.NewProgram(model, tea.WithAltScreen())
 
 		if _, err := p.Run(); err != nil {
@@ -64,16 +73,103 @@ var configShowCmd = &cobra.Command{
 	},
 }
 
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configuration profiles",
+	Run: func(cmd *cobra.Command, args []string) {
+		profiles, err := config.ListProfiles()
+		if err != nil {
+			log.Fatal("Failed to list profiles:", err)
+		}
+
+		active := config.ActiveProfile()
+		for _, profile := range profiles {
+			marker := "  "
+			if profile == active {
+				marker = "* "
+			}
+			fmt.Println(marker + profile)
+		}
+	},
+}
+
+var configUseCmd = &cobra.Command{
+	Use:   "use <profile>",
+	Short: "Set the profile used when --profile and JIRA_REPORT_PROFILE are not given",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.UseProfile(args[0]); err != nil {
+			log.Fatal("Failed to switch profile:", err)
+		}
+		fmt.Printf("✓ Using profile %s\n", args[0])
+	},
+}
+
+var configAddCmd = &cobra.Command{
+	Use:   "add <profile>",
+	Short: "Add a configuration profile interactively",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.AddProfileInteractive(args[0]); err != nil {
+			log.Fatal("Failed to add profile:", err)
+		}
+	},
+}
+
+var configMigrateSecretsCmd = &cobra.Command{
+	Use:   "migrate-secrets",
+	Short: "Move plaintext API tokens from the config file to the OS keyring",
+	Long: `Move the API tokens of every profile from the config file to the OS keyring
+(or a secrets file where no keyring is available), leaving references in the file.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		moved, err := config.MigrateSecrets()
+		for _, secret := range moved {
+			fmt.Printf("✓ Moved %s\n", secret)
+		}
+		if err != nil {
+			log.Fatal("Failed to migrate secrets:", err)
+		}
+		if len(moved) == 0 {
+			fmt.Println("No plaintext secrets found")
+		} else if config.ProbeKeyring() != nil {
+			if warning := config.SecretsFileWarning(config.SecretBackendFile); warning != "" {
+				fmt.Printf("\n%s\n", warning)
+			}
+		}
+	},
+}
+
 func init() {
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Configuration profile to use (default: $JIRA_REPORT_PROFILE or the one set with 'config use')")
 	configCmd.AddCommand(configInitCmd)
 	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configUseCmd)
+	configCmd.AddCommand(configAddCmd)
+	configCmd.AddCommand(configMigrateSecretsCmd)
 	rootCmd.AddCommand(tuiCmd)
 	rootCmd.AddCommand(configCmd)
 }
 
 func main() {
-	if err := rootCmd.Execute(); err != nil {
+	if err := rootCmd.ExecuteContext(interruptContext()); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
+
+// interruptContext returns a context cancelled by the first Ctrl-C, so in-flight requests
+// stop and batches can clean up; a second Ctrl-C exits immediately
+func interruptContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		signal.Stop(signals)
+		fmt.Fprintln(os.Stderr, "\nInterrupted, cancelling... (press Ctrl-C again to quit now)")
+		cancel()
+	}()
+	return ctx
+}
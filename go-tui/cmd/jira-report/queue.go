@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+	"github.com/yourusername/jira-daily-report/internal/tui/refresh"
+)
+
+var queueDropAll bool
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage worklogs and status changes queued while offline",
+	Long: `Writes that fail because the network is down (e.g. the VPN dropped) are queued
+in ~/.jira-daily-report-outbox.json. The TUI replays them automatically once the
+network is back; "queue flush" replays them from the command line.`,
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List queued writes",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		store := newOutboxStore()
+		entries, err := store.List()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(entries) == 0 {
+			fmt.Println("Queue is empty")
+			return
+		}
+
+		for _, entry := range entries {
+			fmt.Printf("%-14s %s  %s\n", entry.ID, entry.CreatedAt.Format("2006-01-02 15:04"), entry.Summary())
+			if entry.Attempts > 0 {
+				fmt.Printf("%-14s failed %d times: %s\n", "", entry.Attempts, entry.LastError)
+			}
+		}
+	},
+}
+
+var queueFlushCmd = &cobra.Command{
+	Use:   "flush",
+	Short: "Replay queued writes now",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		store := newOutboxStore()
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		dedup := refresh.NewOutboxDeduper(refresh.VerifierClients{JiraClient: jiraClient, TempoClient: tempoClient})
+		result, err := outbox.Flush(store, outbox.NewWriter(jiraClient, tempoClient), dedup)
+		if err != nil {
+			log.Fatalf("Failed to replay queue: %v", err)
+		}
+		if result.Busy {
+			fmt.Printf("Another replay is in progress; %d writes queued\n", result.Remaining)
+			return
+		}
+
+		fmt.Printf("Replayed %d, already applied %d, rejected %d, still queued %d\n",
+			result.Replayed, result.Skipped, result.Failed, result.Remaining)
+		if result.Offline {
+			fmt.Println("Still offline; try again once the network is back.")
+		}
+		if result.Failed > 0 {
+			fmt.Println(`See "jira-report queue list" for errors and "queue drop <id>" to discard.`)
+		}
+	},
+}
+
+var queueDropCmd = &cobra.Command{
+	Use:   "drop [id]",
+	Short: "Discard a queued write (or all with --all)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := newOutboxStore()
+
+		if queueDropAll {
+			count, err := store.Clear()
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("Dropped %d queued writes\n", count)
+			return
+		}
+		if len(args) == 0 {
+			log.Fatal("Pass the ID of the write to drop (see \"queue list\") or --all")
+		}
+
+		removed, err := store.Remove(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !removed {
+			log.Fatalf("No queued write with ID %s", args[0])
+		}
+		fmt.Printf("Dropped %s\n", args[0])
+	},
+}
+
+// newOutboxStore opens the outbox of the active profile or exits
+func newOutboxStore() *outbox.Store {
+	path, err := config.StateFile("outbox")
+	if err != nil {
+		log.Fatal(err)
+	}
+	return outbox.NewStoreAt(path)
+}
+
+// queueOfflineWorklog queues a worklog sent at the given time that failed because the network
+// is down, reporting whether it was queued
+func queueOfflineWorklog(issueKey string, request model.WorklogRequest, sent time.Time, err error) bool {
+	if !outbox.IsOffline(err) {
+		return false
+	}
+	path, pathErr := config.StateFile("outbox")
+	if pathErr != nil {
+		return false
+	}
+	store := outbox.NewStoreAt(path)
+	entry := outbox.NewWorklogEntry(issueKey, request)
+	entry.CreatedAt = sent
+	entry.SetError(err)
+	if _, err := store.Add(entry); err != nil {
+		return false
+	}
+	fmt.Printf("📥 Offline: queued %s (replay with \"jira-report queue flush\")\n", entry.Summary())
+	return true
+}
+
+func init() {
+	queueDropCmd.Flags().BoolVar(&queueDropAll, "all", false, "Drop every queued write")
+
+	queueCmd.AddCommand(queueListCmd)
+	queueCmd.AddCommand(queueFlushCmd)
+	queueCmd.AddCommand(queueDropCmd)
+	rootCmd.AddCommand(queueCmd)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+)
+
+var (
+	timerDescription string
+	timerDiscard     bool
+)
+
+var timerCmd = &cobra.Command{
+	Use:   "timer",
+	Short: "Track time on an issue with a start/stop timer",
+	Long: `Track time with a timer that survives restarts. Stopping the timer logs the
+tracked time to Tempo, rounded by the "timerRounding" config setting.`,
+}
+
+var timerStartCmd = &cobra.Command{
+	Use:   "start <issue-key>",
+	Short: "Start a timer on an issue",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		store := newTimerStore()
+		running, err := store.Load()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if running != nil {
+			log.Fatalf("A timer is already running on %s (%s). Stop it first with \"jira-report timer stop\".",
+				running.IssueKey, timer.FormatElapsed(running.Elapsed(time.Now())))
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, _ := newAPIClients(ctx, cfg)
+
+		issueKey := strings.ToUpper(strings.TrimSpace(args[0]))
+		issue, err := jiraClient.FetchIssueContext(ctx, issueKey)
+		if err != nil {
+			log.Fatalf("Failed to find issue %s: %v", issueKey, err)
+		}
+
+		t := &timer.Timer{
+			IssueKey:     issue.Key,
+			IssueID:      issue.ID,
+			IssueSummary: issue.Fields.Summary,
+			Description:  timerDescription,
+			StartedAt:    time.Now(),
+		}
+		if err := store.Save(t); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("⏱ Timer started on %s: %s\n", t.IssueKey, t.IssueSummary)
+	},
+}
+
+var timerStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the timer and log the tracked time to Tempo",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		store := newTimerStore()
+		t, err := store.Load()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if t == nil {
+			log.Fatal("No timer is running")
+		}
+
+		elapsed := t.Elapsed(time.Now())
+		if timerDiscard {
+			if err := store.Clear(); err != nil {
+				log.Fatal(err)
+			}
+			fmt.Printf("Discarded timer on %s (%s)\n", t.IssueKey, timer.FormatElapsed(elapsed))
+			return
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
+
+		issueID, err := strconv.Atoi(t.IssueID)
+		if err != nil {
+			log.Fatalf("Invalid issue ID %q for %s", t.IssueID, t.IssueKey)
+		}
+
+		desc := t.Description
+		if cmd.Flags().Changed("description") {
+			desc = timerDescription
+		}
+
+		rounding := cfg.GetTimerRounding()
+		seconds := rounding.Apply(elapsed)
+		if _, err := tempoClient.CreateWorklogContext(ctx, issueID, seconds, t.StartDate(), desc, user.AccountID); err != nil {
+			// Keep the timer so the time is not lost
+			log.Fatalf("Failed to log time (timer is still running): %v", err)
+		}
+
+		if err := store.Clear(); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("✓ Logged %s to %s on %s (tracked %s, rounded %s to %dm)\n",
+			formatWorklogDuration(seconds), t.IssueKey, t.StartDate(), timer.FormatElapsed(elapsed), rounding.Mode, rounding.Minutes)
+	},
+}
+
+var timerStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the running timer",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		store := newTimerStore()
+		t, err := store.Load()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if t == nil {
+			fmt.Println("No timer is running")
+			return
+		}
+		fmt.Printf("⏱ %s %s (started %s)\n", t.IssueKey, timer.FormatElapsed(t.Elapsed(time.Now())), t.StartedAt.Local().Format("2006-01-02 15:04"))
+		if t.IssueSummary != "" {
+			fmt.Printf("  %s\n", t.IssueSummary)
+		}
+	},
+}
+
+func init() {
+	timerStartCmd.Flags().StringVar(&timerDescription, "description", "", "Worklog description to use when the timer stops")
+	timerStopCmd.Flags().StringVar(&timerDescription, "description", "", "Override the worklog description")
+	timerStopCmd.Flags().BoolVar(&timerDiscard, "discard", false, "Stop the timer without logging time")
+
+	timerCmd.AddCommand(timerStartCmd)
+	timerCmd.AddCommand(timerStopCmd)
+	timerCmd.AddCommand(timerStatusCmd)
+	rootCmd.AddCommand(timerCmd)
+}
+
+// newTimerStore opens the running timer of the active profile or exits
+func newTimerStore() *timer.Store {
+	path, err := config.StateFile("timer")
+	if err != nil {
+		log.Fatal(err)
+	}
+	return timer.NewStoreAt(path)
+}
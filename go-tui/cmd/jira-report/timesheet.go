@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/timesheet"
+)
+
+var (
+	timesheetSince string
+	timesheetUntil string
+)
+
+var timesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Inspect your Tempo timesheet",
+}
+
+var timesheetCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "List workdays with less time logged than required",
+	Long: `Compare the time logged on each workday against the required hours and list
+the missing time per day. Required hours come from the "timesheet" config
+(default 8h on weekdays, minus configured holidays) or, with
+"useTempoSchedule": true, from your Tempo work schedule.
+
+Exits with status 1 when any day is under-logged, so it can be used in scripts.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		from := dateutil.WeekStart(time.Now()).Format("2006-01-02")
+		if timesheetSince != "" {
+			parsed, err := dateutil.ParseWorklogDate(timesheetSince)
+			if err != nil {
+				log.Fatal(err)
+			}
+			from = parsed
+		}
+		until, err := dateutil.ParseWorklogDate(timesheetUntil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if from > until {
+			// By default today is left out, so on Mondays there is nothing to check yet
+			if !cmd.Flags().Changed("until") {
+				fmt.Printf("✓ Nothing to check: the period starts %s and today is still in progress\n", from)
+				return
+			}
+			log.Fatalf("--since %s is after --until %s", from, until)
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
+
+		schedule, err := cfg.GetTimesheetSettings().Schedule(ctx, tempoClient, from, until)
+		if err != nil {
+			log.Fatalf("Failed to load work schedule: %v", err)
+		}
+
+		worklogs, err := tempoClient.FetchWorklogsContext(ctx, user.AccountID, from, until)
+		if err != nil {
+			log.Fatalf("Failed to fetch worklogs: %v", err)
+		}
+
+		gaps := timesheet.Check(schedule, model.GroupWorklogsByDate(worklogs))
+		if len(gaps) == 0 {
+			fmt.Printf("✓ Timesheet complete from %s to %s\n", from, until)
+			return
+		}
+
+		fmt.Printf("Missing time from %s to %s:\n\n", from, until)
+		for _, gap := range gaps {
+			weekday := ""
+			if d, err := time.Parse("2006-01-02", gap.Date); err == nil {
+				weekday = d.Format("Mon")
+			}
+			fmt.Printf("  %s %s  logged %6s of %6s  missing %s\n", weekday, gap.Date,
+				formatWorklogDuration(gap.LoggedSeconds), formatWorklogDuration(gap.RequiredSeconds),
+				formatWorklogDuration(gap.MissingSeconds()))
+		}
+		fmt.Printf("\nTotal missing: %s\n", formatWorklogDuration(timesheet.TotalMissing(gaps)))
+		os.Exit(1)
+	},
+}
+
+func init() {
+	timesheetCheckCmd.Flags().StringVar(&timesheetSince, "since", "", "First day to check (default: Monday of this week)")
+	// Today is still in progress, so like the TUI the check stops at yesterday by default
+	timesheetCheckCmd.Flags().StringVar(&timesheetUntil, "until", "yesterday", "Last day to check ("+dateutil.WorklogDateFormatHelp+")")
+
+	timesheetCmd.AddCommand(timesheetCheckCmd)
+	rootCmd.AddCommand(timesheetCmd)
+}
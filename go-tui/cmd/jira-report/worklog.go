@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+var (
+	worklogDate        string
+	worklogTime        string
+	worklogDescription string
+	worklogYes         bool
+)
+
+var worklogCmd = &cobra.Command{
+	Use:   "worklog",
+	Short: "List, edit and delete Tempo worklogs",
+	Long:  `Manage existing Tempo worklogs. Use "worklog list" to find worklog IDs.`,
+}
+
+var worklogListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your worklogs for a day",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		targetDate, err := dateutil.ParseWorklogDate(worklogDate)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		jiraClient, tempoClient := newAPIClients(ctx, cfg)
+
+		user, err := jiraClient.FetchCurrentUserContext(ctx)
+		if err != nil {
+			log.Fatalf("Failed to fetch user info: %v", err)
+		}
+
+		worklogs, err := tempoClient.FetchWorklogsContext(ctx, user.AccountID, targetDate, targetDate)
+		if err != nil {
+			log.Fatalf("Failed to fetch worklogs: %v", err)
+		}
+		worklogs, err = tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, worklogs)
+		if err != nil {
+			log.Fatalf("Failed to enrich worklogs: %v", err)
+		}
+
+		if len(worklogs) == 0 {
+			fmt.Printf("No worklogs on %s\n", targetDate)
+			return
+		}
+
+		total := 0
+		for _, w := range worklogs {
+			total += w.TimeSpentSeconds
+			fmt.Printf("%-10d %-12s %6s  %s\n", w.TempoWorklogID, w.Issue.Key, formatWorklogDuration(w.TimeSpentSeconds), w.Description)
+		}
+		fmt.Printf("\nTotal on %s: %s\n", targetDate, formatWorklogDuration(total))
+	},
+}
+
+var worklogEditCmd = &cobra.Command{
+	Use:   "edit <worklog-id>",
+	Short: "Change the time, date or description of a worklog",
+	Long: `Change an existing worklog. Only the flags you pass are changed, e.g.:
+
+  jira-report worklog edit 12345 --time 1h30m
+  jira-report worklog edit 12345 --description "Code review" --date yesterday`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		worklogID, err := parseWorklogID(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		flags := cmd.Flags()
+		if !flags.Changed("time") && !flags.Changed("description") && !flags.Changed("date") {
+			log.Fatal("Nothing to change: pass --time, --description and/or --date")
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		_, tempoClient := newAPIClients(ctx, cfg)
+
+		existing, err := tempoClient.FetchWorklogContext(ctx, worklogID)
+		if err != nil {
+			log.Fatalf("Failed to fetch worklog: %v", err)
+		}
+
+		// Tempo replaces the whole worklog, so everything not changed here is sent back as fetched
+		update := model.NewWorklogUpdate(*existing)
+		if flags.Changed("time") {
+			seconds, err := dateutil.ParseDuration(worklogTime)
+			if err != nil || seconds <= 0 {
+				log.Fatalf("Invalid --time %q (e.g. 2h, 30m, 1h30m)", worklogTime)
+			}
+			update.SetTimeSpent(seconds)
+		}
+		if flags.Changed("description") {
+			update.Description = worklogDescription
+		}
+		if flags.Changed("date") {
+			update.StartDate, err = dateutil.ParseWorklogDate(worklogDate)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		if _, err := tempoClient.UpdateWorklogContext(ctx, worklogID, update); err != nil {
+			log.Fatalf("Failed to update worklog: %v", err)
+		}
+		fmt.Printf("✓ Updated worklog %d: %s on %s\n", worklogID, formatWorklogDuration(update.TimeSpentSeconds), update.StartDate)
+	},
+}
+
+var worklogDeleteCmd = &cobra.Command{
+	Use:   "delete <worklog-id>",
+	Short: "Delete a worklog",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		worklogID, err := parseWorklogID(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		cfg, err := config.NewManager()
+		if err != nil {
+			log.Fatalf("Failed to load configuration: %v", err)
+		}
+		_, tempoClient := newAPIClients(ctx, cfg)
+
+		if !worklogYes {
+			existing, err := tempoClient.FetchWorklogContext(ctx, worklogID)
+			if err != nil {
+				log.Fatalf("Failed to fetch worklog: %v", err)
+			}
+			prompt := fmt.Sprintf("Delete worklog %d (%s on %s)?", worklogID, formatWorklogDuration(existing.TimeSpentSeconds), existing.StartDate)
+			if !confirmPrompt(prompt) {
+				fmt.Println("Cancelled.")
+				return
+			}
+		}
+
+		if err := tempoClient.DeleteWorklogContext(ctx, worklogID); err != nil {
+			log.Fatalf("Failed to delete worklog: %v", err)
+		}
+		fmt.Printf("✓ Deleted worklog %d\n", worklogID)
+	},
+}
+
+// parseWorklogID parses a Tempo worklog ID argument
+func parseWorklogID(arg string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(arg))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid worklog ID %q (see \"jira-report worklog list\")", arg)
+	}
+	return id, nil
+}
+
+// formatWorklogDuration formats seconds as e.g. "1h30m", "2h" or "45m"
+func formatWorklogDuration(seconds int) string {
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// confirmPrompt asks a yes/no question on stdin, defaulting to no
+func confirmPrompt(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func init() {
+	worklogListCmd.Flags().StringVarP(&worklogDate, "date", "d", "today", "Day to list ("+dateutil.WorklogDateFormatHelp+")")
+
+	worklogEditCmd.Flags().StringVarP(&worklogTime, "time", "t", "", "New time spent (e.g. 2h, 30m, 1h30m)")
+	worklogEditCmd.Flags().StringVar(&worklogDescription, "description", "", "New description")
+	worklogEditCmd.Flags().StringVarP(&worklogDate, "date", "d", "", "New date ("+dateutil.WorklogDateFormatHelp+")")
+
+	worklogDeleteCmd.Flags().BoolVarP(&worklogYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	worklogCmd.AddCommand(worklogListCmd)
+	worklogCmd.AddCommand(worklogEditCmd)
+	worklogCmd.AddCommand(worklogDeleteCmd)
+	rootCmd.AddCommand(worklogCmd)
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Errors matched with errors.Is against the errors returned by the Jira and Tempo clients
+var (
+	ErrRateLimited  = errors.New("rate limited")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrNotFound     = errors.New("not found")
+)
+
+// maxErrorBody caps how much of an error response is kept in the message
+const maxErrorBody = 4096
+
+// StatusError is returned when Jira or Tempo answers with an unexpected status code
+type StatusError struct {
+	Op         string // What failed, e.g. "failed to fetch issue"
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("%s: %s", e.Op, e.Status)
+	}
+	return fmt.Sprintf("%s: %s - %s", e.Op, e.Status, e.Body)
+}
+
+// Unwrap maps the status code to ErrRateLimited, ErrUnauthorized or ErrNotFound
+func (e *StatusError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+	return nil
+}
+
+// newStatusError reads the response body into a StatusError; the caller still closes it
+func newStatusError(op string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return &StatusError{Op: op, StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body)}
+}
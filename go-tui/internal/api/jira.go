@@ -1,143 +1,91 @@
 package api
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
+	"golang.org/x/oauth2"
+
 	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
-// getCloudIDForSite fetches the cloud ID for a given Jira site URL using OAuth token
-func getCloudIDForSite(siteURL, oauthToken string) (string, error) {
-	// Call Atlassian API to get accessible resources
-	req, err := http.NewRequest("GET", "https://api.atlassian.com/oauth/token/accessible-resources", nil)
-	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+oauthToken)
-	req.Header.Set("Accept", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("failed to get accessible resources: %s - %s", resp.Status, string(body))
-	}
-
-	var sites []struct {
-		ID   string `json:"id"`
-		URL  string `json:"url"`
-		Name string `json:"name"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&sites); err != nil {
-		return "", err
-	}
-
-	// Normalize URLs for comparison
-	normalizedSiteURL := strings.TrimSuffix(strings.TrimSpace(siteURL), "/")
-
-	// Find matching site
-	for _, site := range sites {
-		normalizedURL := strings.TrimSuffix(strings.TrimSpace(site.URL), "/")
-		if normalizedURL == normalizedSiteURL {
-			return site.ID, nil
-		}
-	}
-
-	// If no exact match, return the first site's ID
-	if len(sites) > 0 {
-		return sites[0].ID, nil
-	}
-
-	return "", fmt.Errorf("no accessible Jira sites found")
-}
-
 // JiraClient handles Jira API requests
 type JiraClient struct {
-	baseURL    string
-	username   string
-	apiToken   string
-	oauthToken string // OAuth Bearer token (preferred when set)
-	client     *http.Client
+	baseURL     string
+	username    string
+	apiToken    string
+	tokenSource oauth2.TokenSource // OAuth tokens (preferred when set), refreshed by the transport
+	deployment  model.Deployment
+	client      *http.Client
+
+	// The Flagged custom field differs between sites, so it is configured or looked up once
+	flaggedMu       sync.Mutex
+	flaggedID       string
+	flaggedResolved bool
+	flaggedLookup   chan struct{} // Closed when the lookup in flight finishes; nil if none is
+	flaggedRetryAt  time.Time     // After a failed lookup, when to try again
 }
 
 // NewJiraClient creates a new Jira API client with optimized HTTP transport
 func NewJiraClient(baseURL, username, apiToken string) *JiraClient {
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 20,
-		IdleConnTimeout:     90 * time.Second,
-		DisableCompression:  false,
-	}
-
 	return &JiraClient{
 		baseURL:  baseURL,
 		username: username,
 		apiToken: apiToken,
-		client: &http.Client{
-			Transport: transport,
-			Timeout:   30 * time.Second,
-		},
+		client:   newHTTPClient(),
 	}
 }
 
-// NewOAuthJiraClient creates a new Jira API client using OAuth Bearer token
-// For OAuth with Atlassian Cloud, we need to use api.atlassian.com/ex/jira/{cloudId} pattern
-func NewOAuthJiraClient(siteURL, oauthToken string) *JiraClient {
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 20,
-		IdleConnTimeout:     90 * time.Second,
-		DisableCompression:  false,
+// NewServerJiraClient creates a Jira Server/Data Center client that uses REST API v2 and
+// authenticates with a personal access token
+func NewServerJiraClient(baseURL, personalAccessToken string) *JiraClient {
+	return &JiraClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiToken:   personalAccessToken,
+		deployment: model.DeploymentServer,
+		client:     newHTTPClient(),
 	}
+}
 
-	// Get cloud ID for the site
-	cloudID, err := getCloudIDForSite(siteURL, oauthToken)
-	if err != nil {
-		// Fallback to direct URL if cloud ID fetch fails
-		// This allows the code to work even if there's an issue
-		return &JiraClient{
-			baseURL:    siteURL,
-			oauthToken: oauthToken,
-			client: &http.Client{
-				Transport: transport,
-				Timeout:   30 * time.Second,
-			},
-		}
-	}
+// IsServer reports whether the client talks to Jira Server/Data Center rather than Cloud
+func (c *JiraClient) IsServer() bool {
+	return c.deployment == model.DeploymentServer
+}
 
-	// Use Atlassian Cloud API proxy pattern
-	baseURL := fmt.Sprintf("https://api.atlassian.com/ex/jira/%s", cloudID)
+// NewOAuthJiraClient creates a new Jira API client that authenticates with OAuth Bearer tokens
+// from source, so tokens refreshed by the source are picked up mid-session.
+// For OAuth with Atlassian Cloud, we need to use api.atlassian.com/ex/jira/{cloudId} pattern
+func NewOAuthJiraClient(cloudID string, source oauth2.TokenSource) *JiraClient {
 	return &JiraClient{
-		baseURL:    baseURL,
-		oauthToken: oauthToken,
-		client: &http.Client{
-			Transport: transport,
-			Timeout:   30 * time.Second,
-		},
+		baseURL:     fmt.Sprintf("https://api.atlassian.com/ex/jira/%s", cloudID),
+		tokenSource: source,
+		client:      newOAuthHTTPClient(source),
 	}
 }
 
 // FetchUser retrieves the current user information
 func (c *JiraClient) FetchUser(accountID string) (*model.User, error) {
-	endpoint := fmt.Sprintf("%s/rest/api/3/user", c.baseURL)
+	return c.FetchUserContext(context.Background(), accountID)
+}
+
+// FetchUserContext is like FetchUser but uses ctx for cancellation
+func (c *JiraClient) FetchUserContext(ctx context.Context, accountID string) (*model.User, error) {
+	// Server identifies users by username, Cloud by account ID
+	endpoint := c.restURL("/user")
 	params := url.Values{}
-	params.Add("accountId", accountID)
+	if c.IsServer() {
+		params.Add("username", accountID)
+	} else {
+		params.Add("accountId", accountID)
+	}
 
-	req, err := http.NewRequest("GET", endpoint+"?"+params.Encode(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,23 +100,22 @@ func (c *JiraClient) FetchUser(accountID string) (*model.User, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch user: %s - %s", resp.Status, string(body))
-	}
-
-	var user model.User
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
-		return nil, err
+		return nil, newStatusError("failed to fetch user", resp)
 	}
 
-	return &user, nil
+	return c.decodeUser(resp)
 }
 
 // FetchCurrentUser retrieves the currently authenticated user
 func (c *JiraClient) FetchCurrentUser() (*model.User, error) {
-	endpoint := fmt.Sprintf("%s/rest/api/3/myself", c.baseURL)
+	return c.FetchCurrentUserContext(context.Background())
+}
+
+// FetchCurrentUserContext is like FetchCurrentUser but uses ctx for cancellation
+func (c *JiraClient) FetchCurrentUserContext(ctx context.Context) (*model.User, error) {
+	endpoint := c.restURL("/myself")
 
-	req, err := http.NewRequest("GET", endpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -183,67 +130,78 @@ func (c *JiraClient) FetchCurrentUser() (*model.User, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch current user: %s - %s", resp.Status, string(body))
+		return nil, newStatusError("failed to fetch current user", resp)
 	}
 
-	var user model.User
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
-		return nil, err
-	}
-
-	return &user, nil
+	return c.decodeUser(resp)
 }
 
-// FetchIssue retrieves a single issue by ID or key
-func (c *JiraClient) FetchIssue(issueID string) (*model.Issue, error) {
-	endpoint := fmt.Sprintf("%s/rest/api/3/issue/%s", c.baseURL, issueID)
-	params := url.Values{}
-	params.Add("fields", "key,summary")
+// FetchMyPermissionsContext reports which of the given project permissions (e.g. "BROWSE_PROJECTS")
+// the authenticated user has in at least one project
+func (c *JiraClient) FetchMyPermissionsContext(ctx context.Context, permissions ...string) (map[string]bool, error) {
+	query := url.Values{}
+	query.Set("permissions", strings.Join(permissions, ","))
+	endpoint := c.restURL("/mypermissions") + "?" + query.Encode()
 
-	req, err := http.NewRequest("GET", endpoint+"?"+params.Encode(), nil)
+	req, err := c.buildRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	c.setAuth(req)
-	req.Header.Set("Content-Type", "application/json")
-
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to fetch permissions: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch issue: %s - %s", resp.Status, string(body))
+		return nil, newStatusError("failed to fetch permissions", resp)
 	}
 
-	var issue model.Issue
-	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
-		return nil, err
+	var result struct {
+		Permissions map[string]struct {
+			HavePermission bool `json:"havePermission"`
+		} `json:"permissions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return &issue, nil
+	granted := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		granted[permission] = result.Permissions[permission].HavePermission
+	}
+	return granted, nil
 }
 
-// FetchTasks retrieves tasks with a JQL query using POST to avoid 410 Gone or URL length limits
-func (c *JiraClient) FetchTasks(jql string) ([]model.Issue, error) {
-	endpoint := fmt.Sprintf("%s/rest/api/3/search/jql", c.baseURL)
-
-	payload := map[string]interface{}{
-		"jql":        jql,
-		"maxResults": 100,
-		"fields":     []string{"key", "summary", "status", "issuetype", "parent", "priority", "assignee", "description", "fixVersions"},
+// decodeUser decodes a user, using the username as the account ID on Server/Data Center
+func (c *JiraClient) decodeUser(resp *http.Response) (*model.User, error) {
+	var user struct {
+		model.User
+		Name string `json:"name"` // Server/Data Center only
 	}
-
-	bodyBytes, err := json.Marshal(payload)
-	if err != nil {
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(bodyBytes))
+	if c.IsServer() {
+		user.AccountID = user.Name
+	}
+	return &user.User, nil
+}
+
+// FetchIssue retrieves a single issue by ID or key
+func (c *JiraClient) FetchIssue(issueID string) (*model.Issue, error) {
+	return c.FetchIssueContext(context.Background(), issueID)
+}
+
+// FetchIssueContext is like FetchIssue but uses ctx for cancellation
+func (c *JiraClient) FetchIssueContext(ctx context.Context, issueID string) (*model.Issue, error) {
+	endpoint := c.restURL("/issue/" + issueID)
+	params := url.Values{}
+	params.Add("fields", "key,summary")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -258,16 +216,23 @@ func (c *JiraClient) FetchTasks(jql string) ([]model.Issue, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch tasks: %s - %s", resp.Status, string(body))
+		return nil, newStatusError("failed to fetch issue", resp)
 	}
 
-	var result struct {
-		Issues []model.Issue `json:"issues"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var issue model.Issue
+	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
 		return nil, err
 	}
 
-	return result.Issues, nil
+	return &issue, nil
+}
+
+// FetchTasks retrieves all tasks matching a JQL query (used for worklog enrichment)
+func (c *JiraClient) FetchTasks(jql string) ([]model.Issue, error) {
+	return c.FetchTasksContext(context.Background(), jql)
+}
+
+// FetchTasksContext is like FetchTasks but uses ctx for cancellation
+func (c *JiraClient) FetchTasksContext(ctx context.Context, jql string) ([]model.Issue, error) {
+	return c.collectJQL(ctx, jql, []string{"key", "summary", "status", "issuetype", "parent", "priority", "assignee", "description", "fixVersions"})
 }
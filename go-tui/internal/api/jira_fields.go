@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// flaggedRetryDelay is how long searches use the usual Cloud ID after a failed /field lookup
+const flaggedRetryDelay = time.Minute
+
+// SetFlaggedField sets the ID of the custom field holding the Flagged marker, e.g.
+// "customfield_10021". An empty ID leaves it to be looked up by name.
+func (c *JiraClient) SetFlaggedField(id string) {
+	c.flaggedMu.Lock()
+	defer c.flaggedMu.Unlock()
+	c.flaggedID = id
+	c.flaggedResolved = id != ""
+	c.flaggedRetryAt = time.Time{}
+}
+
+// flaggedField returns the ID of the site's Flagged field, looked up by name in /field the first
+// time, or "" if the site has none. Concurrent searches share one lookup, and while it is in
+// flight or for a minute after it failed, the usual Cloud ID is assumed.
+func (c *JiraClient) flaggedField(ctx context.Context) string {
+	c.flaggedMu.Lock()
+	if c.flaggedResolved || time.Now().Before(c.flaggedRetryAt) {
+		c.flaggedMu.Unlock()
+		return c.currentFlaggedField()
+	}
+	if done := c.flaggedLookup; done != nil {
+		c.flaggedMu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		return c.currentFlaggedField()
+	}
+	done := make(chan struct{})
+	c.flaggedLookup = done
+	c.flaggedMu.Unlock()
+
+	// The request runs without the lock, so other searches are not held up by retries
+	id, err := c.findFieldID(ctx, model.FlaggedFieldName)
+
+	c.flaggedMu.Lock()
+	switch {
+	case err == nil:
+		c.flaggedID, c.flaggedResolved = id, true
+	case ctx.Err() == nil:
+		// A cancelled search says nothing about the site, so only real failures wait to retry
+		c.flaggedRetryAt = time.Now().Add(flaggedRetryDelay)
+	}
+	c.flaggedLookup = nil
+	close(done)
+	c.flaggedMu.Unlock()
+	return c.currentFlaggedField()
+}
+
+// currentFlaggedField returns the Flagged field ID if it is known, else the usual Cloud ID
+func (c *JiraClient) currentFlaggedField() string {
+	c.flaggedMu.Lock()
+	defer c.flaggedMu.Unlock()
+	if c.flaggedResolved {
+		return c.flaggedID
+	}
+	return model.DefaultFlaggedField
+}
+
+// findFieldID returns the ID of the field called name (case-insensitive), or "" if there is none
+func (c *JiraClient) findFieldID(ctx context.Context, name string) (string, error) {
+	req, err := c.buildRequest(ctx, "GET", c.restURL("/field"), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch fields: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", newStatusError("failed to fetch fields", resp)
+	}
+
+	var fields []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := c.decodeResponse(resp, &fields); err != nil {
+		return "", fmt.Errorf("failed to decode fields: %w", err)
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// flaggedJQL returns a JQL condition matching issues with the Flagged field set
+func flaggedJQL(field string) string {
+	if number, ok := strings.CutPrefix(field, "customfield_"); ok {
+		return fmt.Sprintf("cf[%s] is not EMPTY", number)
+	}
+	return field + " is not EMPTY"
+}
+
+// decodeIssues decodes search results and reads the Flagged marker from the site's Flagged field
+func (c *JiraClient) decodeIssues(raw []json.RawMessage) ([]model.Issue, error) {
+	field := c.currentFlaggedField()
+
+	issues := make([]model.Issue, len(raw))
+	for i, data := range raw {
+		if err := json.Unmarshal(data, &issues[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode issue: %w", err)
+		}
+		if field == "" {
+			continue
+		}
+
+		var custom struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		}
+		if err := json.Unmarshal(data, &custom); err != nil {
+			return nil, fmt.Errorf("failed to decode issue: %w", err)
+		}
+		value, ok := custom.Fields[field]
+		if !ok || string(value) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(value, &issues[i].Fields.Flagged); err != nil {
+			// A value of another shape still marks the issue as flagged
+			issues[i].Fields.Flagged = []model.FlagOption{{Value: model.FlaggedFieldName}}
+		}
+	}
+	return issues, nil
+}
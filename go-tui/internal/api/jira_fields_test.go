@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestFetchBlockedTasksUsesDiscoveredFlaggedField(t *testing.T) {
+	var jql string
+	var fields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"},{"id":"customfield_10100","name":"Flagged"}]`)
+		case "/rest/api/3/search/jql":
+			var body struct {
+				JQL    string   `json:"jql"`
+				Fields []string `json:"fields"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			jql, fields = body.JQL, body.Fields
+			fmt.Fprint(w, `{"issues":[
+				{"key":"A-1","fields":{"summary":"x","customfield_10100":[{"value":"Impediment"}]}},
+				{"key":"A-2","fields":{"summary":"y","customfield_10100":null}}],"isLast":true}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "user", "token")
+	issues, err := client.FetchBlockedTasks("me", []string{"Won't Fix"})
+	if err != nil {
+		t.Fatalf("FetchBlockedTasks() error = %v", err)
+	}
+
+	if !strings.Contains(jql, "cf[10100] is not EMPTY") || !strings.Contains(jql, `status IN ('Won\'t Fix')`) {
+		t.Errorf("unexpected JQL %q", jql)
+	}
+	if fields[len(fields)-1] != "customfield_10100" {
+		t.Errorf("fields = %v, want the Flagged field requested", fields)
+	}
+	if len(issues) != 2 || len(issues[0].Fields.Flagged) != 1 || len(issues[1].Fields.Flagged) != 0 {
+		t.Errorf("expected only A-1 flagged, got %+v", issues)
+	}
+}
+
+func TestFlaggedFieldLookupIsSharedAndFailuresAreCached(t *testing.T) {
+	var mu sync.Mutex
+	fieldRequests := 0
+	var searchedFields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			mu.Lock()
+			fieldRequests++
+			mu.Unlock()
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/rest/api/3/search/jql":
+			var body struct {
+				Fields []string `json:"fields"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			searchedFields = append(searchedFields, body.Fields[len(body.Fields)-1])
+			mu.Unlock()
+			fmt.Fprint(w, `{"issues":[],"isLast":true}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "user", "token")
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.FetchTasksByJQL("project = A"); err != nil {
+				t.Errorf("FetchTasksByJQL() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := client.FetchTasksByJQL("project = A"); err != nil {
+		t.Fatalf("FetchTasksByJQL() error = %v", err)
+	}
+
+	if fieldRequests != 1 {
+		t.Errorf("expected one /field lookup, got %d", fieldRequests)
+	}
+	for _, field := range searchedFields {
+		if field != model.DefaultFlaggedField {
+			t.Errorf("searched with %q, want the usual Cloud ID while the lookup fails", field)
+		}
+	}
+}
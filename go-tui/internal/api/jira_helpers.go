@@ -2,13 +2,14 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 )
 
 // buildRequest creates an HTTP request with authentication
-func (c *JiraClient) buildRequest(method, url string, body interface{}) (*http.Request, error) {
+func (c *JiraClient) buildRequest(ctx context.Context, method, url string, body interface{}) (*http.Request, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonData, err := json.Marshal(body)
@@ -18,7 +19,7 @@ func (c *JiraClient) buildRequest(method, url string, body interface{}) (*http.R
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -30,13 +31,25 @@ func (c *JiraClient) buildRequest(method, url string, body interface{}) (*http.R
 
 // setAuth sets authentication header - prefers OAuth Bearer token, falls back to Basic Auth
 func (c *JiraClient) setAuth(req *http.Request) {
-	if c.oauthToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.oauthToken)
-	} else {
+	switch {
+	case c.tokenSource != nil:
+		// OAuth clients get the Bearer header from their transport
+	case c.IsServer():
+		// Server/Data Center personal access tokens are Bearer tokens
+		req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	default:
 		req.SetBasicAuth(c.username, c.apiToken)
 	}
 }
 
+// restURL returns the URL of a Jira REST endpoint: API v3 on Cloud, v2 on Server/Data Center
+func (c *JiraClient) restURL(path string) string {
+	if c.IsServer() {
+		return c.baseURL + "/rest/api/2" + path
+	}
+	return c.baseURL + "/rest/api/3" + path
+}
+
 // decodeResponse decodes a JSON response
 func (c *JiraClient) decodeResponse(resp *http.Response, v interface{}) error {
 	return json.NewDecoder(resp.Body).Decode(v)
@@ -1,13 +1,16 @@
 package api
 
 import (
-	"fmt"
-
+	"context"
 	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
 // FetchUnderReviewTasks fetches tasks under review
-func (c *JiraClient) FetchUnderReviewTasks(username string) ([]model.Issue, error) {
-	jql := fmt.Sprintf(`assignee = '%s' AND status IN ('Under Review', 'Code Review', 'Review')`, username)
-	return c.FetchTasksByJQL(jql)
+func (c *JiraClient) FetchUnderReviewTasks(username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.FetchUnderReviewTasksContext(context.Background(), username, statuses)
+}
+
+// FetchUnderReviewTasksContext is like FetchUnderReviewTasks but uses ctx for cancellation
+func (c *JiraClient) FetchUnderReviewTasksContext(ctx context.Context, username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.fetchStatusGroup(ctx, username, statuses, model.StatusGroupReview)
 }
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// jiraSearchPageSize is the page size requested from /search/jql (Jira caps it at 100 with fields)
+const jiraSearchPageSize = 100
+
+// taskFieldNames are the issue fields requested for task lists, besides the Flagged field
+var taskFieldNames = []string{"summary", "status", "issuetype", "parent", "priority", "description", "updated", "fixVersions", "issuelinks"}
+
+// taskFields returns the fields requested for task lists, including the site's Flagged field
+func (c *JiraClient) taskFields(ctx context.Context) []string {
+	fields := append([]string{}, taskFieldNames...)
+	if field := c.flaggedField(ctx); field != "" {
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// StreamTasksByJQL runs a JQL search and calls onPage for every page of results,
+// following nextPageToken until the last page. Returning an error from onPage stops the search.
+func (c *JiraClient) StreamTasksByJQL(jql string, onPage func([]model.Issue) error) error {
+	return c.StreamTasksByJQLContext(context.Background(), jql, onPage)
+}
+
+// StreamTasksByJQLContext is like StreamTasksByJQL but uses ctx for cancellation
+func (c *JiraClient) StreamTasksByJQLContext(ctx context.Context, jql string, onPage func([]model.Issue) error) error {
+	return c.searchJQL(ctx, jql, c.taskFields(ctx), onPage)
+}
+
+// searchJQL pages through /rest/api/3/search/jql for the given fields
+func (c *JiraClient) searchJQL(ctx context.Context, jql string, fields []string, onPage func([]model.Issue) error) error {
+	if c.IsServer() {
+		return c.searchJQLServer(ctx, jql, fields, onPage)
+	}
+
+	// Use the correct Jira search endpoint (migrated to /search/jql)
+	endpoint := fmt.Sprintf("%s/rest/api/3/search/jql", c.baseURL)
+
+	nextPageToken := ""
+	for {
+		// Build request body (POST method is recommended for Jira API)
+		requestBody := map[string]interface{}{
+			"jql":        jql,
+			"fields":     fields,
+			"maxResults": jiraSearchPageSize,
+		}
+		if nextPageToken != "" {
+			requestBody["nextPageToken"] = nextPageToken
+		}
+
+		req, err := c.buildRequest(ctx, "POST", endpoint, requestBody)
+		if err != nil {
+			return err
+		}
+
+		// Searching only reads, so the POST is safe to retry
+		resp, err := c.client.Do(markIdempotent(req))
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != 200 {
+			err := newStatusError("failed to fetch tasks", resp)
+			resp.Body.Close()
+			return err
+		}
+
+		var result struct {
+			Issues        []json.RawMessage `json:"issues"`
+			NextPageToken string            `json:"nextPageToken"`
+			IsLast        bool              `json:"isLast"`
+		}
+		err = c.decodeResponse(resp, &result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		issues, err := c.decodeIssues(result.Issues)
+		if err != nil {
+			return err
+		}
+		if err := onPage(issues); err != nil {
+			return err
+		}
+
+		// Stop on the last page, or if the server repeats a token (defensive against loops)
+		if result.IsLast || result.NextPageToken == "" || result.NextPageToken == nextPageToken {
+			return nil
+		}
+		nextPageToken = result.NextPageToken
+	}
+}
+
+// searchJQLServer pages through /rest/api/2/search on Server/Data Center, which pages by offset
+func (c *JiraClient) searchJQLServer(ctx context.Context, jql string, fields []string, onPage func([]model.Issue) error) error {
+	endpoint := c.restURL("/search")
+
+	startAt := 0
+	for {
+		requestBody := map[string]interface{}{
+			"jql":        jql,
+			"fields":     fields,
+			"startAt":    startAt,
+			"maxResults": jiraSearchPageSize,
+		}
+
+		req, err := c.buildRequest(ctx, "POST", endpoint, requestBody)
+		if err != nil {
+			return err
+		}
+
+		// Searching only reads, so the POST is safe to retry
+		resp, err := c.client.Do(markIdempotent(req))
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != 200 {
+			err := newStatusError("failed to fetch tasks", resp)
+			resp.Body.Close()
+			return err
+		}
+
+		var result struct {
+			Issues []json.RawMessage `json:"issues"`
+			Total  int               `json:"total"`
+		}
+		err = c.decodeResponse(resp, &result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		issues, err := c.decodeIssues(result.Issues)
+		if err != nil {
+			return err
+		}
+		if err := onPage(issues); err != nil {
+			return err
+		}
+
+		// The server may cap maxResults below the page size, so advance by what it returned
+		startAt += len(result.Issues)
+		if len(result.Issues) == 0 || startAt >= result.Total {
+			return nil
+		}
+	}
+}
+
+// collectJQL runs searchJQL and returns every page as a single slice
+func (c *JiraClient) collectJQL(ctx context.Context, jql string, fields []string) ([]model.Issue, error) {
+	issues := []model.Issue{}
+	err := c.searchJQL(ctx, jql, fields, func(page []model.Issue) error {
+		issues = append(issues, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
@@ -1,105 +1,179 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
-// FetchTasksByJQL fetches tasks using a JQL query
+// FetchTasksByJQL fetches all tasks matching a JQL query, following every result page
 func (c *JiraClient) FetchTasksByJQL(jql string) ([]model.Issue, error) {
-	// Use the correct Jira search endpoint (migrated to /search/jql)
-	endpoint := fmt.Sprintf("%s/rest/api/3/search/jql", c.baseURL)
-
-	// Build request body (POST method is recommended for Jira API)
-	requestBody := map[string]interface{}{
-		"jql":        jql,
-		"fields":     []string{"summary", "status", "issuetype", "parent", "priority", "description", "updated", "fixVersions"},
-		"maxResults": 100,
-	}
-
-	req, err := c.buildRequest("POST", endpoint, requestBody)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		body, _ := c.readBody(resp)
-		return nil, fmt.Errorf("failed to fetch tasks: status %d - %s", resp.StatusCode, string(body))
-	}
-
-	var result struct {
-		Issues []model.Issue `json:"issues"`
-	}
-	if err := c.decodeResponse(resp, &result); err != nil {
-		return nil, err
-	}
+	return c.FetchTasksByJQLContext(context.Background(), jql)
+}
 
-	return result.Issues, nil
+// FetchTasksByJQLContext is like FetchTasksByJQL but uses ctx for cancellation
+func (c *JiraClient) FetchTasksByJQLContext(ctx context.Context, jql string) ([]model.Issue, error) {
+	return c.collectJQL(ctx, jql, c.taskFields(ctx))
 }
 
 // FetchAllTasks fetches all tasks (In Progress, Open, Under Review, Ready for Testing)
 // in a single optimized JQL query instead of 4 separate queries
-func (c *JiraClient) FetchAllTasks(username string) (inProgress, todo, underReview, testing []model.Issue, err error) {
-	// Combine all status queries into one JQL with OR conditions
+func (c *JiraClient) FetchAllTasks(username string, statuses model.StatusMapping) (inProgress, todo, underReview, testing []model.Issue, err error) {
+	return c.FetchAllTasksContext(context.Background(), username, statuses)
+}
+
+// FetchAllTasksContext is like FetchAllTasks but uses ctx for cancellation
+func (c *JiraClient) FetchAllTasksContext(ctx context.Context, username string, statuses model.StatusMapping) (inProgress, todo, underReview, testing []model.Issue, err error) {
+	// Combine all status groups into one JQL with OR conditions
 	// This reduces 4 HTTP requests to 1, significantly improving load time
-	jql := fmt.Sprintf(`assignee = '%s' AND status IN (
-		'In Progress',
-		'Open',
-		'Selected for Development',
-		'Under Review',
-		'Code Review',
-		'Review',
-		'Ready for Testing',
-		'QA',
-		'Testing',
-		'To Test'
-	)`, username)
-
-	issues, err := c.FetchTasksByJQL(jql)
+	jql := fmt.Sprintf(`assignee = '%s' AND %s`, username, statuses.JQL(
+		model.StatusGroupInProgress,
+		model.StatusGroupTodo,
+		model.StatusGroupReview,
+		model.StatusGroupTesting,
+	))
+
+	issues, err := c.FetchTasksByJQLContext(ctx, jql)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
-	// Categorize issues by status
+	inProgress, todo, underReview, testing = CategorizeTasks(issues, statuses)
+	return inProgress, todo, underReview, testing, nil
+}
+
+// CategorizeTasks splits issues into the task panel groups by status (falling back to the
+// Jira status category); issues outside every group are dropped
+func CategorizeTasks(issues []model.Issue, statuses model.StatusMapping) (inProgress, todo, underReview, testing []model.Issue) {
 	for _, issue := range issues {
-		status := issue.Fields.Status.Name
+		group, ok := statuses.Group(issue.Fields.Status)
+		if !ok {
+			continue
+		}
 
-		switch status {
-		case "In Progress":
+		switch group {
+		case model.StatusGroupInProgress:
 			inProgress = append(inProgress, issue)
-		case "Open", "Selected for Development":
+		case model.StatusGroupTodo:
 			todo = append(todo, issue)
-		case "Under Review", "Code Review", "Review":
+		case model.StatusGroupReview:
 			underReview = append(underReview, issue)
-		case "Ready for Testing", "QA", "Testing", "To Test":
+		case model.StatusGroupTesting:
 			testing = append(testing, issue)
 		}
 	}
+	return inProgress, todo, underReview, testing
+}
 
-	return inProgress, todo, underReview, testing, nil
+// FetchTaskChanges fetches the tasks FetchAllTasks would return that changed since a previous
+// sync, plus the keys of issues that left the task panels since then (moved to another status
+// or reassigned). Issues deleted in Jira are not reported.
+func (c *JiraClient) FetchTaskChanges(username string, statuses model.StatusMapping, since time.Time) (updated []model.Issue, removedKeys []string, err error) {
+	return c.FetchTaskChangesContext(context.Background(), username, statuses, since)
+}
+
+// FetchTaskChangesContext is like FetchTaskChanges but uses ctx for cancellation
+func (c *JiraClient) FetchTaskChangesContext(ctx context.Context, username string, statuses model.StatusMapping, since time.Time) (updated []model.Issue, removedKeys []string, err error) {
+	taskFilter := statuses.JQL(
+		model.StatusGroupInProgress,
+		model.StatusGroupTodo,
+		model.StatusGroupReview,
+		model.StatusGroupTesting,
+	)
+	// A relative date avoids depending on the timezone configured in the Jira profile
+	updatedFilter := fmt.Sprintf("updated >= -%dm", int(time.Since(since).Minutes())+1)
+
+	jql := fmt.Sprintf(`assignee = '%s' AND %s AND %s`, username, taskFilter, updatedFilter)
+	updated, err = c.FetchTasksByJQLContext(ctx, jql)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// "!=" never matches an empty field, so unassigned issues are checked separately
+	jql = fmt.Sprintf(`assignee WAS '%s' AND %s AND (assignee != '%s' OR assignee IS EMPTY OR NOT %s)`,
+		username, updatedFilter, username, taskFilter)
+	left, err := c.collectJQL(ctx, jql, []string{"key"})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, issue := range left {
+		removedKeys = append(removedKeys, issue.Key)
+	}
+
+	return updated, removedKeys, nil
+}
+
+// FetchInProgressTasks fetches tasks in an in-progress status
+func (c *JiraClient) FetchInProgressTasks(username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.FetchInProgressTasksContext(context.Background(), username, statuses)
 }
 
-// FetchInProgressTasks fetches tasks in "In Progress" status
-func (c *JiraClient) FetchInProgressTasks(username string) ([]model.Issue, error) {
-	jql := fmt.Sprintf(`assignee = '%s' AND status = 'In Progress'`, username)
-	return c.FetchTasksByJQL(jql)
+// FetchInProgressTasksContext is like FetchInProgressTasks but uses ctx for cancellation
+func (c *JiraClient) FetchInProgressTasksContext(ctx context.Context, username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.fetchStatusGroup(ctx, username, statuses, model.StatusGroupInProgress)
 }
 
 // FetchOpenTasks fetches open tasks
-func (c *JiraClient) FetchOpenTasks(username string) ([]model.Issue, error) {
-	jql := fmt.Sprintf(`assignee = '%s' AND status IN ('Open', 'Selected for Development')`, username)
-	return c.FetchTasksByJQL(jql)
+func (c *JiraClient) FetchOpenTasks(username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.FetchOpenTasksContext(context.Background(), username, statuses)
+}
+
+// FetchOpenTasksContext is like FetchOpenTasks but uses ctx for cancellation
+func (c *JiraClient) FetchOpenTasksContext(ctx context.Context, username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.fetchStatusGroup(ctx, username, statuses, model.StatusGroupTodo)
+}
+
+// FetchBlockedTasks fetches unresolved tasks that may be blocked: flagged, in one of
+// blockedStatuses, or linked as "is blocked by" (link resolution is checked by the caller)
+func (c *JiraClient) FetchBlockedTasks(username string, blockedStatuses []string) ([]model.Issue, error) {
+	return c.FetchBlockedTasksContext(context.Background(), username, blockedStatuses)
+}
+
+// FetchBlockedTasksContext is like FetchBlockedTasks but uses ctx for cancellation
+func (c *JiraClient) FetchBlockedTasksContext(ctx context.Context, username string, blockedStatuses []string) ([]model.Issue, error) {
+	conditions := []string{`issueLinkType = "is blocked by"`}
+	if field := c.flaggedField(ctx); field != "" {
+		conditions = append(conditions, flaggedJQL(field))
+	}
+	if len(blockedStatuses) > 0 {
+		quoted := make([]string, len(blockedStatuses))
+		for i, s := range blockedStatuses {
+			quoted[i] = model.QuoteJQL(s)
+		}
+		conditions = append(conditions, fmt.Sprintf("status IN (%s)", strings.Join(quoted, ", ")))
+	}
+
+	jql := fmt.Sprintf(`assignee = '%s' AND resolution = Unresolved AND (%s)`, username, strings.Join(conditions, " OR "))
+	return c.FetchTasksByJQLContext(ctx, jql)
 }
 
 // FetchReadyForTestingTasks fetches tasks ready for testing
-func (c *JiraClient) FetchReadyForTestingTasks(username string) ([]model.Issue, error) {
-	jql := fmt.Sprintf(`assignee = '%s' AND status IN ('Ready for Testing', 'QA', 'Testing', 'To Test')`, username)
-	return c.FetchTasksByJQL(jql)
+func (c *JiraClient) FetchReadyForTestingTasks(username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.FetchReadyForTestingTasksContext(context.Background(), username, statuses)
+}
+
+// FetchReadyForTestingTasksContext is like FetchReadyForTestingTasks but uses ctx for cancellation
+func (c *JiraClient) FetchReadyForTestingTasksContext(ctx context.Context, username string, statuses model.StatusMapping) ([]model.Issue, error) {
+	return c.fetchStatusGroup(ctx, username, statuses, model.StatusGroupTesting)
+}
+
+// fetchStatusGroup fetches the user's tasks in one status group. The JQL may match a wider
+// status category, so results are filtered with the same rules FetchAllTasks uses.
+func (c *JiraClient) fetchStatusGroup(ctx context.Context, username string, statuses model.StatusMapping, group model.StatusGroup) ([]model.Issue, error) {
+	jql := fmt.Sprintf(`assignee = '%s' AND %s`, username, statuses.JQL(group))
+	issues, err := c.FetchTasksByJQLContext(ctx, jql)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []model.Issue
+	for _, issue := range issues {
+		if g, ok := statuses.Group(issue.Fields.Status); ok && g == group {
+			result = append(result, issue)
+		}
+	}
+	return result, nil
 }
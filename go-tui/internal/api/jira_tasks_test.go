@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestFetchTaskChangesReturnsUpdatedAndRemovedIssues(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		jql, _ := body["jql"].(string)
+		queries = append(queries, jql)
+
+		if strings.HasPrefix(jql, "assignee WAS") {
+			fmt.Fprint(w, `{"issues":[{"key":"A-2"}],"isLast":true}`)
+			return
+		}
+		fmt.Fprint(w, `{"issues":[{"key":"A-1","fields":{"status":{"name":"In Progress"}}}],"isLast":true}`)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "user", "token")
+	client.SetFlaggedField(model.DefaultFlaggedField)
+	updated, removed, err := client.FetchTaskChanges("me@example.com", model.DefaultStatusMapping(), time.Now().Add(-90*time.Minute))
+	if err != nil {
+		t.Fatalf("FetchTaskChanges() error = %v", err)
+	}
+
+	if len(updated) != 1 || updated[0].Key != "A-1" {
+		t.Errorf("unexpected updated issues: %+v", updated)
+	}
+	if len(removed) != 1 || removed[0] != "A-2" {
+		t.Errorf("unexpected removed keys: %v", removed)
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "updated >= -91m") || !strings.Contains(queries[1], "assignee IS EMPTY") {
+		t.Errorf("unexpected queries: %q", queries)
+	}
+}
+
+func TestFetchTasksByJQLContextStopsWhenCancelled(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"issues":[],"isLast":true}`)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewJiraClient(server.URL, "user", "token").FetchTasksByJQLContext(ctx, "project = A")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to be sent, got %d", requests)
+	}
+}
+
+func TestFetchMyPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/mypermissions" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("permissions"); got != "BROWSE_PROJECTS,WORK_ON_ISSUES" {
+			t.Errorf("permissions = %q", got)
+		}
+		fmt.Fprint(w, `{"permissions":{"BROWSE_PROJECTS":{"havePermission":true},"WORK_ON_ISSUES":{"havePermission":false}}}`)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "user", "token")
+	granted, err := client.FetchMyPermissionsContext(context.Background(), "BROWSE_PROJECTS", "WORK_ON_ISSUES")
+	if err != nil {
+		t.Fatalf("FetchMyPermissionsContext() error = %v", err)
+	}
+	if !granted["BROWSE_PROJECTS"] || granted["WORK_ON_ISSUES"] {
+		t.Errorf("granted = %v", granted)
+	}
+}
@@ -2,9 +2,9 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/yourusername/jira-daily-report/internal/jira"
@@ -12,9 +12,14 @@ import (
 
 // GetTransitions fetches available status transitions for an issue
 func (c *JiraClient) GetTransitions(issueKey string) ([]jira.Transition, error) {
-	endpoint := fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", c.baseURL, issueKey)
+	return c.GetTransitionsContext(context.Background(), issueKey)
+}
+
+// GetTransitionsContext is like GetTransitions but uses ctx for cancellation
+func (c *JiraClient) GetTransitionsContext(ctx context.Context, issueKey string) ([]jira.Transition, error) {
+	endpoint := c.restURL(fmt.Sprintf("/issue/%s/transitions", issueKey))
 
-	req, err := http.NewRequest("GET", endpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,8 +34,7 @@ func (c *JiraClient) GetTransitions(issueKey string) ([]jira.Transition, error)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch transitions: %s - %s", resp.Status, string(body))
+		return nil, newStatusError("failed to fetch transitions", resp)
 	}
 
 	var result jira.TransitionsResponse
@@ -53,7 +57,12 @@ type TransitionData struct {
 
 // TransitionIssue performs a status transition on an issue
 func (c *JiraClient) TransitionIssue(issueKey string, transitionID string) error {
-	endpoint := fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", c.baseURL, issueKey)
+	return c.TransitionIssueContext(context.Background(), issueKey, transitionID)
+}
+
+// TransitionIssueContext is like TransitionIssue but uses ctx for cancellation
+func (c *JiraClient) TransitionIssueContext(ctx context.Context, issueKey string, transitionID string) error {
+	endpoint := c.restURL(fmt.Sprintf("/issue/%s/transitions", issueKey))
 
 	payload := TransitionRequest{
 		Transition: TransitionData{
@@ -66,7 +75,7 @@ func (c *JiraClient) TransitionIssue(issueKey string, transitionID string) error
 		return err
 	}
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return err
 	}
@@ -81,8 +90,7 @@ func (c *JiraClient) TransitionIssue(issueKey string, transitionID string) error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to transition issue: %s - %s", resp.Status, string(body))
+		return newStatusError("failed to transition issue", resp)
 	}
 
 	return nil
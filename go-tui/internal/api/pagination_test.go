@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestFetchTasksByJQLFollowsNextPageToken(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		token, _ := body["nextPageToken"].(string)
+		tokens = append(tokens, token)
+
+		switch token {
+		case "":
+			fmt.Fprint(w, `{"issues":[{"key":"A-1"},{"key":"A-2"}],"nextPageToken":"p2"}`)
+		case "p2":
+			fmt.Fprint(w, `{"issues":[{"key":"A-3"}],"isLast":true}`)
+		default:
+			t.Fatalf("unexpected page token %q", token)
+		}
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "user", "token")
+	client.SetFlaggedField(model.DefaultFlaggedField)
+	issues, err := client.FetchTasksByJQL("assignee = currentUser()")
+	if err != nil {
+		t.Fatalf("FetchTasksByJQL() error = %v", err)
+	}
+
+	if len(issues) != 3 || issues[2].Key != "A-3" {
+		t.Errorf("expected 3 issues across pages, got %+v", issues)
+	}
+	if len(tokens) != 2 || tokens[1] != "p2" {
+		t.Errorf("expected two requests following the token, got %v", tokens)
+	}
+}
+
+func TestStreamTasksByJQLStopsOnCallbackError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"issues":[{"key":"A-1"}],"nextPageToken":"more"}`)
+	}))
+	defer server.Close()
+
+	stop := fmt.Errorf("stop")
+	client := NewJiraClient(server.URL, "user", "token")
+	client.SetFlaggedField(model.DefaultFlaggedField)
+	err := client.StreamTasksByJQL("project = A", func(page []model.Issue) error {
+		return stop
+	})
+
+	if err != stop {
+		t.Errorf("StreamTasksByJQL() error = %v, want callback error", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
+
+func TestFetchWorklogsFollowsMetadataNext(t *testing.T) {
+	var server *httptest.Server
+	var bodies []string
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		bodies = append(bodies, fmt.Sprint(body["from"]))
+
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"metadata":{"next":"%s/worklogs/search?offset=1&limit=1"},"results":[{"tempoWorklogId":1}]}`, server.URL)
+			return
+		}
+		fmt.Fprint(w, `{"metadata":{},"results":[{"tempoWorklogId":2}]}`)
+	}))
+	defer server.Close()
+
+	client := NewTempoClient("token", nil)
+	client.baseURL = server.URL
+
+	worklogs, err := client.FetchWorklogs("acc", "2026-04-01", "2026-04-30")
+	if err != nil {
+		t.Fatalf("FetchWorklogs() error = %v", err)
+	}
+
+	if len(worklogs) != 2 || worklogs[1].TempoWorklogID != 2 {
+		t.Errorf("expected worklogs from both pages, got %+v", worklogs)
+	}
+	if len(bodies) != 2 || bodies[1] != "2026-04-01" {
+		t.Errorf("expected the search body to be resent for the next page, got %v", bodies)
+	}
+}
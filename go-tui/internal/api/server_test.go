@@ -0,0 +1,159 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestServerJiraClientUsesRESTv2AndPAT(t *testing.T) {
+	var startAts []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer pat" {
+			t.Errorf("Authorization = %q, want the PAT as a Bearer token", got)
+		}
+		switch r.URL.Path {
+		case "/rest/api/2/search":
+			var body struct {
+				StartAt int `json:"startAt"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			startAts = append(startAts, body.StartAt)
+			// The server caps pages at two issues
+			fmt.Fprintf(w, `{"issues":[{"key":"A-%d"},{"key":"A-%d"}],"total":3}`, body.StartAt+1, body.StartAt+2)
+		case "/rest/api/2/user":
+			if got := r.URL.Query().Get("username"); got != "jdoe" {
+				t.Errorf("username = %q", got)
+			}
+			fmt.Fprint(w, `{"name":"jdoe","key":"JIRAUSER10100","displayName":"Jane Doe"}`)
+		case "/rest/api/2/field":
+			// No Jira Software, so no Flagged field
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewServerJiraClient(server.URL+"/", "pat")
+
+	issues, err := client.FetchTasksByJQL("project = A")
+	if err != nil {
+		t.Fatalf("FetchTasksByJQL() error = %v", err)
+	}
+	if len(issues) != 4 || len(startAts) != 2 || startAts[1] != 2 {
+		t.Errorf("expected two pages by offset, got %d issues from %v", len(issues), startAts)
+	}
+
+	user, err := client.FetchUser("jdoe")
+	if err != nil {
+		t.Fatalf("FetchUser() error = %v", err)
+	}
+	if user.AccountID != "jdoe" || user.DisplayName != "Jane Doe" {
+		t.Errorf("expected the username as account ID, got %+v", user)
+	}
+}
+
+func TestTempoServerClientWorklogs(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /rest/tempo-timesheets/4/worklogs/search":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if fmt.Sprint(body["worker"]) != "[jdoe]" {
+				t.Errorf("worker = %v", body["worker"])
+			}
+			fmt.Fprint(w, `[{"tempoWorklogId":7,"timeSpentSeconds":3600,"started":"2026-04-01 09:30:00.000","comment":"Review","worker":"jdoe","issue":{"id":10001,"key":"A-1","summary":"Fix login"}}]`)
+		case "POST /rest/tempo-timesheets/4/worklogs":
+			json.NewDecoder(r.Body).Decode(&created)
+			fmt.Fprint(w, `[{"tempoWorklogId":8,"jiraWorklogId":80,"timeSpent":"1h","started":"2026-04-02 00:00:00.000","issue":{"id":10001,"key":"A-1"}}]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewTempoServerClient(NewServerJiraClient(server.URL, "pat"))
+
+	worklogs, err := client.FetchWorklogs("jdoe", "2026-04-01", "2026-04-07")
+	if err != nil {
+		t.Fatalf("FetchWorklogs() error = %v", err)
+	}
+	want := model.Worklog{
+		TempoWorklogID:   7,
+		Issue:            model.WorklogIssue{ID: 10001, Key: "A-1", Summary: "Fix login"},
+		TimeSpentSeconds: 3600,
+		StartDate:        "2026-04-01",
+		StartTime:        "09:30:00",
+		Description:      "Review",
+		Author:           model.Author{AccountID: "jdoe"},
+	}
+	if len(worklogs) != 1 || !reflect.DeepEqual(worklogs[0], want) {
+		t.Errorf("FetchWorklogs() = %+v, want %+v", worklogs, want)
+	}
+
+	resp, err := client.CreateWorklog(10001, 3600, "2026-04-02", "", "jdoe")
+	if err != nil {
+		t.Fatalf("CreateWorklog() error = %v", err)
+	}
+	if resp.TempoWorklogID != 8 || resp.Issue.Key != "A-1" || resp.StartDate != "2026-04-02" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	if created["originTaskId"] != "10001" || created["worker"] != "jdoe" || created["started"] != "2026-04-02T00:00:00.000" {
+		t.Errorf("unexpected create body %v", created)
+	}
+}
+
+func TestTempoServerUpdateSendsBillableAndAttributes(t *testing.T) {
+	var updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/tempo-timesheets/4/worklogs/7":
+			fmt.Fprint(w, `{"tempoWorklogId":7,"timeSpentSeconds":3600,"billableSeconds":1800,"started":"2026-04-01 09:30:00.000","worker":"jdoe",
+				"issue":{"id":10001,"key":"A-1"},"attributes":{"_WorkType_":{"key":"_WorkType_","value":"Dev"}}}`)
+		case "PUT /rest/tempo-timesheets/4/worklogs/7":
+			json.NewDecoder(r.Body).Decode(&updated)
+			fmt.Fprint(w, `{"tempoWorklogId":7,"started":"2026-04-01 09:30:00.000","issue":{"id":10001,"key":"A-1"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewTempoServerClient(NewServerJiraClient(server.URL, "pat"))
+
+	existing, err := client.FetchWorklog(7)
+	if err != nil {
+		t.Fatalf("FetchWorklog() error = %v", err)
+	}
+	update := model.NewWorklogUpdate(*existing)
+	update.SetTimeSpent(5400)
+	if _, err := client.UpdateWorklog(7, update); err != nil {
+		t.Fatalf("UpdateWorklog() error = %v", err)
+	}
+	if updated["timeSpentSeconds"] != float64(5400) || updated["billableSeconds"] != float64(1800) {
+		t.Errorf("unexpected update body %v", updated)
+	}
+	if fmt.Sprint(updated["attributes"]) != "map[_WorkType_:map[value:Dev]]" {
+		t.Errorf("attributes = %v, want _WorkType_=Dev", updated["attributes"])
+	}
+
+	// Billable time comes from the update, not from the worklog as stored
+	update.BillableSeconds = nil
+	updated = nil
+	if _, err := client.UpdateWorklog(7, update); err != nil {
+		t.Fatalf("UpdateWorklog() error = %v", err)
+	}
+	if _, ok := updated["billableSeconds"]; ok {
+		t.Errorf("billableSeconds = %v, want the stored value not to be sent back", updated["billableSeconds"])
+	}
+}
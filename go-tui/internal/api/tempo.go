@@ -2,9 +2,9 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,100 +16,205 @@ import (
 
 const (
 	tempoBaseURL   = "https://api.tempo.io/4"
-	maxIDsPerQuery = 500 // JQL supports up to 500-1000 IDs per query
+	tempoPageSize  = 1000 // Tempo's maximum page size for worklog search
+	maxIDsPerQuery = 500  // JQL supports up to 500-1000 IDs per query
 )
 
+// worklogAPI is the worklog API of one Tempo deployment. TempoClient builds paging helpers,
+// issue enrichment and caching on top of it; Tempo Cloud (api.tempo.io/4) and Tempo Server/Data
+// Center (/rest/tempo-timesheets/4) each implement it.
+type worklogAPI interface {
+	searchWorklogs(ctx context.Context, query worklogQuery, onPage func([]model.Worklog) error) error
+	fetchWorklog(ctx context.Context, worklogID int) (*model.Worklog, error)
+	createWorklog(ctx context.Context, worklog model.WorklogRequest) (*model.WorklogResponse, error)
+	updateWorklog(ctx context.Context, worklogID int, update model.WorklogUpdate) (*model.WorklogResponse, error)
+	deleteWorklog(ctx context.Context, worklogID int) error
+	fetchWorkAttributes(ctx context.Context) ([]model.WorkAttribute, error)
+	fetchUserSchedule(ctx context.Context, from, to string) ([]model.ScheduleDay, error)
+}
+
+// worklogQuery selects the worklogs of one author in a date range
+type worklogQuery struct {
+	authorID    string // Account ID on Cloud, username on Server
+	from, to    string // YYYY-MM-DD
+	updatedFrom time.Time
+}
+
 // TempoClient handles Tempo API requests
 type TempoClient struct {
+	baseURL    string
 	apiToken   string
 	jiraClient *JiraClient
 	client     *http.Client
+	api        worklogAPI
 	// Cache for enriched issue details to avoid repeated API calls
 	issueCache      map[int]model.Issue
 	issueCacheMutex sync.RWMutex
 }
 
+// tempoCloud implements worklogAPI with the Tempo Cloud REST API v4
+type tempoCloud struct {
+	*TempoClient
+}
+
 // NewTempoClient creates a new Tempo API client with optimized HTTP transport
 func NewTempoClient(apiToken string, jiraClient *JiraClient) *TempoClient {
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 20,
-		IdleConnTimeout:     90 * time.Second,
-		DisableCompression:  false,
+	c := &TempoClient{
+		baseURL:    tempoBaseURL,
+		apiToken:   apiToken,
+		jiraClient: jiraClient,
+		client:     newHTTPClient(),
+		issueCache: make(map[int]model.Issue),
 	}
+	c.api = tempoCloud{c}
+	return c
+}
 
-	return &TempoClient{
-		apiToken:    apiToken,
-		jiraClient:  jiraClient,
-		client: &http.Client{
-			Transport: transport,
-			Timeout:   30 * time.Second,
-		},
-		issueCache:  make(map[int]model.Issue),
+// FetchWorklogs retrieves all worklogs for a date range, following every result page
+func (c *TempoClient) FetchWorklogs(accountID, startDate, endDate string) ([]model.Worklog, error) {
+	return c.FetchWorklogsContext(context.Background(), accountID, startDate, endDate)
+}
+
+// FetchWorklogsContext is like FetchWorklogs but uses ctx for cancellation
+func (c *TempoClient) FetchWorklogsContext(ctx context.Context, accountID, startDate, endDate string) ([]model.Worklog, error) {
+	worklogs := []model.Worklog{}
+	err := c.StreamWorklogsContext(ctx, accountID, startDate, endDate, func(page []model.Worklog) error {
+		worklogs = append(worklogs, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return worklogs, nil
 }
 
-// FetchWorklogs retrieves worklogs for a date range
-// OPTIMIZED: Increased limit to reduce pagination
-func (c *TempoClient) FetchWorklogs(accountID, startDate, endDate string) ([]model.Worklog, error) {
-	endpoint := fmt.Sprintf("%s/worklogs/search", tempoBaseURL)
+// StreamWorklogs searches worklogs for a date range and calls onPage for every page of
+// results, following metadata.next until the last page. Returning an error from onPage stops the search.
+func (c *TempoClient) StreamWorklogs(accountID, startDate, endDate string, onPage func([]model.Worklog) error) error {
+	return c.StreamWorklogsContext(context.Background(), accountID, startDate, endDate, onPage)
+}
+
+// StreamWorklogsContext is like StreamWorklogs but uses ctx for cancellation
+func (c *TempoClient) StreamWorklogsContext(ctx context.Context, accountID, startDate, endDate string, onPage func([]model.Worklog) error) error {
+	return c.api.searchWorklogs(ctx, worklogQuery{authorID: accountID, from: startDate, to: endDate}, onPage)
+}
+
+// StreamWorklogsUpdatedSince streams the worklogs in a date range that were created or
+// changed on or after the day of since. Deleted worklogs are not returned. Tempo Server
+// cannot filter by update time, so it returns every worklog in the range.
+func (c *TempoClient) StreamWorklogsUpdatedSince(accountID, startDate, endDate string, since time.Time, onPage func([]model.Worklog) error) error {
+	return c.StreamWorklogsUpdatedSinceContext(context.Background(), accountID, startDate, endDate, since, onPage)
+}
+
+// StreamWorklogsUpdatedSinceContext is like StreamWorklogsUpdatedSince but uses ctx for cancellation
+func (c *TempoClient) StreamWorklogsUpdatedSinceContext(ctx context.Context, accountID, startDate, endDate string, since time.Time, onPage func([]model.Worklog) error) error {
+	return c.api.searchWorklogs(ctx, worklogQuery{authorID: accountID, from: startDate, to: endDate, updatedFrom: since}, onPage)
+}
+
+// searchWorklogs pages through /worklogs/search for the query
+func (c tempoCloud) searchWorklogs(ctx context.Context, query worklogQuery, onPage func([]model.Worklog) error) error {
+	endpoint := fmt.Sprintf("%s/worklogs/search?limit=%d", c.baseURL, tempoPageSize)
 
 	requestBody := map[string]interface{}{
-		"authorIds": []string{accountID},
-		"from":      startDate,
-		"to":        endDate,
-		"limit":     1000, // Increased from 100 to 1000 to reduce API calls
+		"authorIds": []string{query.authorID},
+		"from":      query.from,
+		"to":        query.to,
+	}
+	if !query.updatedFrom.IsZero() {
+		requestBody["updatedFrom"] = query.updatedFrom.UTC().Format("2006-01-02")
 	}
 
 	bodyBytes, err := json.Marshal(requestBody)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(bodyBytes))
-	if err != nil {
-		return nil, err
-	}
+	for endpoint != "" {
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(bodyBytes))
+		if err != nil {
+			return err
+		}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
-	req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+		req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+		// Searching only reads, so the POST is safe to retry
+		resp, err := c.client.Do(markIdempotent(req))
+		if err != nil {
+			return err
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to fetch worklogs: %s - %s", resp.Status, string(body))
-	}
+		if resp.StatusCode != http.StatusOK {
+			err := newStatusError("failed to fetch worklogs", resp)
+			resp.Body.Close()
+			return err
+		}
 
-	var result struct {
-		Results []model.Worklog `json:"results"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+		var result struct {
+			Metadata struct {
+				Next string `json:"next"`
+			} `json:"metadata"`
+			Results []model.Worklog `json:"results"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if err := onPage(result.Results); err != nil {
+			return err
+		}
+
+		// Tempo returns the next page as a full URL; the search body must be sent again
+		if result.Metadata.Next == endpoint {
+			break
+		}
+		endpoint = result.Metadata.Next
 	}
 
-	return result.Results, nil
+	return nil
 }
 
 // FetchLastSixDaysWorklogs retrieves worklogs for the last 6 working days
 func (c *TempoClient) FetchLastSixDaysWorklogs(accountID string) ([]model.Worklog, error) {
+	return c.FetchLastSixDaysWorklogsContext(context.Background(), accountID)
+}
+
+// FetchLastSixDaysWorklogsContext is like FetchLastSixDaysWorklogs but uses ctx for cancellation
+func (c *TempoClient) FetchLastSixDaysWorklogsContext(ctx context.Context, accountID string) ([]model.Worklog, error) {
+	startDate, endDate := LastSixDaysRange()
+	return c.FetchWorklogsContext(ctx, accountID, startDate, endDate)
+}
+
+// StreamLastSixDaysWorklogs streams worklogs for the last 6 working days page by page
+func (c *TempoClient) StreamLastSixDaysWorklogs(accountID string, onPage func([]model.Worklog) error) error {
+	return c.StreamLastSixDaysWorklogsContext(context.Background(), accountID, onPage)
+}
+
+// StreamLastSixDaysWorklogsContext is like StreamLastSixDaysWorklogs but uses ctx for cancellation
+func (c *TempoClient) StreamLastSixDaysWorklogsContext(ctx context.Context, accountID string, onPage func([]model.Worklog) error) error {
+	startDate, endDate := LastSixDaysRange()
+	return c.StreamWorklogsContext(ctx, accountID, startDate, endDate, onPage)
+}
+
+// LastSixDaysRange returns the date range covering the last 6 working days
+func LastSixDaysRange() (string, string) {
 	// Calculate date range (last 10 calendar days to cover 6 working days)
 	endDate := time.Now()
 	startDate := endDate.AddDate(0, 0, -10)
 
-	startDateStr := startDate.Format("2006-01-02")
-	endDateStr := endDate.Format("2006-01-02")
-
-	return c.FetchWorklogs(accountID, startDateStr, endDateStr)
+	return startDate.Format("2006-01-02"), endDate.Format("2006-01-02")
 }
 
 // EnrichWorklogsWithIssueDetails fetches issue details from Jira and enriches worklogs
 // OPTIMIZED: Uses single query with all issue IDs instead of batching, plus caching
 func (c *TempoClient) EnrichWorklogsWithIssueDetails(worklogs []model.Worklog) ([]model.Worklog, error) {
+	return c.EnrichWorklogsWithIssueDetailsContext(context.Background(), worklogs)
+}
+
+// EnrichWorklogsWithIssueDetailsContext is like EnrichWorklogsWithIssueDetails but uses ctx for cancellation
+func (c *TempoClient) EnrichWorklogsWithIssueDetailsContext(ctx context.Context, worklogs []model.Worklog) ([]model.Worklog, error) {
 	// Collect unique issue IDs that need enrichment
 	issueIDsToFetch := make(map[int]bool)
 	for _, log := range worklogs {
@@ -136,32 +241,33 @@ func (c *TempoClient) EnrichWorklogsWithIssueDetails(worklogs []model.Worklog) (
 	for id := range issueIDsToFetch {
 		ids = append(ids, fmt.Sprintf("%d", id))
 	}
+	if err := c.fetchIssuesByID(ctx, ids); err != nil {
+		return nil, err
+	}
 
-	// Split into multiple queries if needed (JQL has limits)
-	issueDetailsMap := make(map[int]model.Issue)
-
-	for i := 0; i < len(ids); i += maxIDsPerQuery {
-		end := i + maxIDsPerQuery
-		if end > len(ids) {
-			end = len(ids)
+	// The epic of a sub-task is its parent's parent, so fetch the parents of sub-tasks too
+	parentIDs := make(map[string]bool)
+	c.issueCacheMutex.RLock()
+	for id := range issueIDsToFetch {
+		issue, ok := c.issueCache[id]
+		if !ok || !issue.Fields.IssueType.Subtask || issue.Fields.Parent == nil {
+			continue
 		}
-
-		batchIDs := ids[i:end]
-		jql := fmt.Sprintf("id in (%s)", strings.Join(batchIDs, ","))
-
-		issues, err := c.jiraClient.FetchTasks(jql)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch issues: %w", err)
+		if parentID, err := strconv.Atoi(issue.Fields.Parent.ID); err == nil {
+			if _, cached := c.issueCache[parentID]; !cached {
+				parentIDs[issue.Fields.Parent.ID] = true
+			}
 		}
-
-		// Update cache and map
-		c.issueCacheMutex.Lock()
-		for _, issue := range issues {
-			idInt, _ := strconv.Atoi(issue.ID)
-			issueDetailsMap[idInt] = issue
-			c.issueCache[idInt] = issue
+	}
+	c.issueCacheMutex.RUnlock()
+	if len(parentIDs) > 0 {
+		ids = ids[:0]
+		for id := range parentIDs {
+			ids = append(ids, id)
+		}
+		if err := c.fetchIssuesByID(ctx, ids); err != nil {
+			return nil, err
 		}
-		c.issueCacheMutex.Unlock()
 	}
 
 	// Enrich worklogs with issue details
@@ -172,8 +278,7 @@ func (c *TempoClient) EnrichWorklogsWithIssueDetails(worklogs []model.Worklog) (
 			// Try to get from cache or newly fetched details
 			c.issueCacheMutex.RLock()
 			if issue, ok := c.issueCache[log.Issue.ID]; ok {
-				enrichedWorklogs[i].Issue.Key = issue.Key
-				enrichedWorklogs[i].Issue.Summary = issue.Fields.Summary
+				c.applyIssueDetails(&enrichedWorklogs[i].Issue, issue)
 			}
 			c.issueCacheMutex.RUnlock()
 		}
@@ -192,8 +297,7 @@ func (c *TempoClient) enrichFromCache(worklogs []model.Worklog) []model.Worklog
 		enrichedWorklogs[i] = log
 		if log.Issue.Key == "" && log.Issue.ID != 0 {
 			if issue, ok := c.issueCache[log.Issue.ID]; ok {
-				enrichedWorklogs[i].Issue.Key = issue.Key
-				enrichedWorklogs[i].Issue.Summary = issue.Fields.Summary
+				c.applyIssueDetails(&enrichedWorklogs[i].Issue, issue)
 			}
 		}
 	}
@@ -201,6 +305,74 @@ func (c *TempoClient) enrichFromCache(worklogs []model.Worklog) []model.Worklog
 	return enrichedWorklogs
 }
 
+// fetchIssuesByID fetches issues by ID in batches and adds them to the issue cache
+func (c *TempoClient) fetchIssuesByID(ctx context.Context, ids []string) error {
+	for i := 0; i < len(ids); i += maxIDsPerQuery {
+		end := i + maxIDsPerQuery
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		jql := fmt.Sprintf("id in (%s)", strings.Join(ids[i:end], ","))
+		issues, err := c.jiraClient.FetchTasksContext(ctx, jql)
+		if err != nil {
+			return fmt.Errorf("failed to fetch issues: %w", err)
+		}
+
+		c.issueCacheMutex.Lock()
+		for _, issue := range issues {
+			idInt, _ := strconv.Atoi(issue.ID)
+			c.issueCache[idInt] = issue
+		}
+		c.issueCacheMutex.Unlock()
+	}
+	return nil
+}
+
+// applyIssueDetails copies key, summary and epic from a Jira issue onto a worklog issue. The epic
+// of a sub-task is looked up through its cached parent; callers hold the issue cache lock.
+func (c *TempoClient) applyIssueDetails(target *model.WorklogIssue, issue model.Issue) {
+	target.Key = issue.Key
+	target.Summary = issue.Fields.Summary
+
+	epic := issue.Fields.Parent
+	if epic != nil && issue.Fields.IssueType.Subtask {
+		epic = nil
+		if parentID, err := strconv.Atoi(issue.Fields.Parent.ID); err == nil {
+			if parent, ok := c.issueCache[parentID]; ok {
+				epic = parent.Fields.Parent
+			}
+		}
+	}
+	if epic != nil {
+		target.EpicKey = epic.Key
+		target.EpicSummary = epic.Fields.Summary
+	}
+}
+
+// SeedIssueCache fills the issue cache from already enriched worklogs (e.g. loaded from
+// the disk cache) so enriching their updates needs no Jira requests
+func (c *TempoClient) SeedIssueCache(worklogs []model.Worklog) {
+	c.issueCacheMutex.Lock()
+	defer c.issueCacheMutex.Unlock()
+
+	for _, log := range worklogs {
+		if log.Issue.ID == 0 || log.Issue.Key == "" {
+			continue
+		}
+		if _, ok := c.issueCache[log.Issue.ID]; ok {
+			continue
+		}
+		issue := model.Issue{ID: strconv.Itoa(log.Issue.ID), Key: log.Issue.Key}
+		issue.Fields.Summary = log.Issue.Summary
+		if log.Issue.EpicKey != "" {
+			issue.Fields.Parent = &model.IssueParent{Key: log.Issue.EpicKey}
+			issue.Fields.Parent.Fields.Summary = log.Issue.EpicSummary
+		}
+		c.issueCache[log.Issue.ID] = issue
+	}
+}
+
 // ClearCache clears the issue cache (useful for testing or forced refresh)
 func (c *TempoClient) ClearCache() {
 	c.issueCacheMutex.Lock()
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// FetchWorkAttributes retrieves the work attributes configured in Tempo, following every result page
+func (c *TempoClient) FetchWorkAttributes() ([]model.WorkAttribute, error) {
+	return c.FetchWorkAttributesContext(context.Background())
+}
+
+// FetchWorkAttributesContext is like FetchWorkAttributes but uses ctx for cancellation
+func (c *TempoClient) FetchWorkAttributesContext(ctx context.Context) ([]model.WorkAttribute, error) {
+	return c.api.fetchWorkAttributes(ctx)
+}
+
+// fetchWorkAttributes pages through GET /work-attributes
+func (c tempoCloud) fetchWorkAttributes(ctx context.Context) ([]model.WorkAttribute, error) {
+	endpoint := fmt.Sprintf("%s/work-attributes", c.baseURL)
+	attributes := []model.WorkAttribute{}
+
+	for endpoint != "" {
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch work attributes: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			err := newStatusError("failed to fetch work attributes", resp)
+			resp.Body.Close()
+			return nil, err
+		}
+
+		var result struct {
+			Metadata struct {
+				Next string `json:"next"`
+			} `json:"metadata"`
+			Results []model.WorkAttribute `json:"results"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		attributes = append(attributes, result.Results...)
+
+		if result.Metadata.Next == endpoint {
+			break
+		}
+		endpoint = result.Metadata.Next
+	}
+
+	return attributes, nil
+}
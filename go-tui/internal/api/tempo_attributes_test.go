@@ -0,0 +1,79 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestFetchWorkAttributesFollowsPages(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"metadata":{"next":"%s/work-attributes?offset=1"},"results":[{"key":"_WorkType_","name":"Work Type","type":"STATIC_LIST","required":true,"values":["Dev","Meeting"],"names":{"Dev":"Development"}}]}`, server.URL)
+			return
+		}
+		fmt.Fprint(w, `{"metadata":{},"results":[{"key":"_Billable_","name":"Billable","type":"CHECKBOX"}]}`)
+	}))
+	defer server.Close()
+
+	client := NewTempoClient("token", nil)
+	client.baseURL = server.URL
+
+	attributes, err := client.FetchWorkAttributes()
+	if err != nil {
+		t.Fatalf("FetchWorkAttributes() error = %v", err)
+	}
+	if len(attributes) != 2 {
+		t.Fatalf("expected 2 attributes, got %d", len(attributes))
+	}
+	if !attributes[0].Required || attributes[0].ValueName("Dev") != "Development" || len(attributes[0].Values) != 2 {
+		t.Errorf("unexpected first attribute: %+v", attributes[0])
+	}
+	if attributes[1].Type != model.WorkAttributeCheckbox {
+		t.Errorf("unexpected second attribute: %+v", attributes[1])
+	}
+}
+
+func TestCreateWorklogRequestSendsOptionalFields(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		fmt.Fprint(w, `{"tempoWorklogId":1}`)
+	}))
+	defer server.Close()
+
+	client := NewTempoClient("token", nil)
+	client.baseURL = server.URL
+
+	billable := 0
+	_, err := client.CreateWorklogRequest(model.WorklogRequest{
+		IssueID:          10,
+		TimeSpentSeconds: 3600,
+		StartDate:        "2026-04-01",
+		StartTime:        "09:00:00",
+		AuthorAccountID:  "acc",
+		BillableSeconds:  &billable,
+		Attributes:       []model.WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateWorklogRequest() error = %v", err)
+	}
+
+	if got["startTime"] != "09:00:00" || got["billableSeconds"] != float64(0) {
+		t.Errorf("unexpected start time or billable seconds: %v", got)
+	}
+	attributes, ok := got["attributes"].([]interface{})
+	if !ok || len(attributes) != 1 {
+		t.Fatalf("unexpected attributes: %v", got["attributes"])
+	}
+	if _, ok := got["description"]; ok {
+		t.Error("empty description should be omitted")
+	}
+}
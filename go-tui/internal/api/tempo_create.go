@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,20 +12,55 @@ import (
 
 // CreateWorklog creates a new worklog entry in Tempo
 func (c *TempoClient) CreateWorklog(issueID int, timeSpentSeconds int, startDate string, description string, authorAccountID string) (*model.WorklogResponse, error) {
+	return c.CreateWorklogContext(context.Background(), issueID, timeSpentSeconds, startDate, description, authorAccountID)
+}
+
+// CreateWorklogContext is like CreateWorklog but uses ctx for cancellation
+func (c *TempoClient) CreateWorklogContext(ctx context.Context, issueID int, timeSpentSeconds int, startDate string, description string, authorAccountID string) (*model.WorklogResponse, error) {
+	return c.CreateWorklogRequestContext(ctx, model.WorklogRequest{
+		IssueID:          issueID,
+		TimeSpentSeconds: timeSpentSeconds,
+		StartDate:        startDate,
+		Description:      description,
+		AuthorAccountID:  authorAccountID,
+	})
+}
+
+// CreateWorklogRequest creates a worklog with optional start time, billable time and work attributes
+func (c *TempoClient) CreateWorklogRequest(worklog model.WorklogRequest) (*model.WorklogResponse, error) {
+	return c.CreateWorklogRequestContext(context.Background(), worklog)
+}
+
+// CreateWorklogRequestContext is like CreateWorklogRequest but uses ctx for cancellation
+func (c *TempoClient) CreateWorklogRequestContext(ctx context.Context, worklog model.WorklogRequest) (*model.WorklogResponse, error) {
+	return c.api.createWorklog(ctx, worklog)
+}
+
+// createWorklog creates a worklog with POST /worklogs
+func (c tempoCloud) createWorklog(ctx context.Context, worklog model.WorklogRequest) (*model.WorklogResponse, error) {
 	// Use the correct Tempo API v4 endpoint
-	url := fmt.Sprintf("%s/worklogs", tempoBaseURL)
+	url := fmt.Sprintf("%s/worklogs", c.baseURL)
 
 	// Tempo API v4 requires issueId as string, not int
 	request := map[string]interface{}{
-		"issueId":          fmt.Sprintf("%d", issueID),
-		"timeSpentSeconds": timeSpentSeconds,
-		"startDate":        startDate,
-		"authorAccountId":  authorAccountID,
+		"issueId":          fmt.Sprintf("%d", worklog.IssueID),
+		"timeSpentSeconds": worklog.TimeSpentSeconds,
+		"startDate":        worklog.StartDate,
+		"authorAccountId":  worklog.AuthorAccountID,
 	}
 
-	// Only add description if provided
-	if description != "" {
-		request["description"] = description
+	// Only add optional fields if provided
+	if worklog.Description != "" {
+		request["description"] = worklog.Description
+	}
+	if worklog.StartTime != "" {
+		request["startTime"] = worklog.StartTime
+	}
+	if worklog.BillableSeconds != nil {
+		request["billableSeconds"] = *worklog.BillableSeconds
+	}
+	if len(worklog.Attributes) > 0 {
+		request["attributes"] = worklog.Attributes
 	}
 
 	jsonData, err := json.Marshal(request)
@@ -32,7 +68,7 @@ func (c *TempoClient) CreateWorklog(issueID int, timeSpentSeconds int, startDate
 		return nil, fmt.Errorf("failed to marshal worklog request: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -47,7 +83,7 @@ func (c *TempoClient) CreateWorklog(issueID int, timeSpentSeconds int, startDate
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("tempo API returned status %d", resp.StatusCode)
+		return nil, newStatusError("failed to create worklog", resp)
 	}
 
 	var worklogResp model.WorklogResponse
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// FetchUserSchedule retrieves the authenticated user's work schedule (required time per day,
+// non-working days and holidays) from Tempo for a date range
+func (c *TempoClient) FetchUserSchedule(from, to string) ([]model.ScheduleDay, error) {
+	return c.FetchUserScheduleContext(context.Background(), from, to)
+}
+
+// FetchUserScheduleContext is like FetchUserSchedule but uses ctx for cancellation
+func (c *TempoClient) FetchUserScheduleContext(ctx context.Context, from, to string) ([]model.ScheduleDay, error) {
+	return c.api.fetchUserSchedule(ctx, from, to)
+}
+
+// fetchUserSchedule fetches GET /user-schedule
+func (c tempoCloud) fetchUserSchedule(ctx context.Context, from, to string) ([]model.ScheduleDay, error) {
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", to)
+	endpoint := fmt.Sprintf("%s/user-schedule?%s", c.baseURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError("failed to fetch user schedule", resp)
+	}
+
+	var result struct {
+		Results []model.ScheduleDay `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Results, nil
+}
@@ -0,0 +1,320 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// tempoServer implements worklogAPI with the Tempo Timesheets REST API of Jira Server/Data Center
+type tempoServer struct {
+	*TempoClient
+	coreURL string // Tempo Core REST API, for work attributes and schedules
+}
+
+// NewTempoServerClient creates a Tempo client for Jira Server/Data Center. Tempo runs inside Jira
+// there, so it uses the Jira client's base URL and personal access token.
+func NewTempoServerClient(jiraClient *JiraClient) *TempoClient {
+	c := &TempoClient{
+		baseURL:    jiraClient.baseURL + "/rest/tempo-timesheets/4",
+		apiToken:   jiraClient.apiToken,
+		jiraClient: jiraClient,
+		client:     newHTTPClient(),
+		issueCache: make(map[int]model.Issue),
+	}
+	c.api = tempoServer{TempoClient: c, coreURL: jiraClient.baseURL + "/rest/tempo-core/1"}
+	return c
+}
+
+// serverWorklog is a worklog as returned by Tempo Server
+type serverWorklog struct {
+	TempoWorklogID   int    `json:"tempoWorklogId"`
+	JiraWorklogID    int    `json:"jiraWorklogId"`
+	TimeSpent        string `json:"timeSpent"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	Started          string `json:"started"` // e.g. "2026-04-01 09:00:00.000"
+	Comment          string `json:"comment"`
+	Worker           string `json:"worker"`      // Username or user key
+	DateCreated      string `json:"dateCreated"` // e.g. "2026-04-01 09:00:05.000", in the server's time zone
+	BillableSeconds  *int   `json:"billableSeconds"`
+	Attributes       map[string]struct {
+		Value string `json:"value"`
+	} `json:"attributes"` // Keyed by attribute key
+	Issue struct {
+		ID      int    `json:"id"`
+		Key     string `json:"key"`
+		Summary string `json:"summary"`
+	} `json:"issue"`
+}
+
+// toWorklog converts to the Cloud-shaped worklog used everywhere else
+func (w serverWorklog) toWorklog() model.Worklog {
+	startDate, startTime := splitStarted(w.Started)
+	worklog := model.Worklog{
+		TempoWorklogID:   w.TempoWorklogID,
+		Issue:            model.WorklogIssue{ID: w.Issue.ID, Key: w.Issue.Key, Summary: w.Issue.Summary},
+		TimeSpentSeconds: w.TimeSpentSeconds,
+		StartDate:        startDate,
+		StartTime:        startTime,
+		Description:      w.Comment,
+		Author:           model.Author{AccountID: w.Worker},
+		BillableSeconds:  w.BillableSeconds,
+	}
+	// Server times carry no zone; the server is assumed to share the local one
+	if created, err := time.ParseInLocation("2006-01-02 15:04:05.000", w.DateCreated, time.Local); err == nil {
+		worklog.CreatedAt = created
+	}
+	for key, attribute := range w.Attributes {
+		worklog.Attributes = append(worklog.Attributes, model.WorkAttributeValue{Key: key, Value: attribute.Value})
+	}
+	sort.Slice(worklog.Attributes, func(i, j int) bool { return worklog.Attributes[i].Key < worklog.Attributes[j].Key })
+	return worklog
+}
+
+// toResponse converts to the response of a create or update
+func (w serverWorklog) toResponse() *model.WorklogResponse {
+	startDate, _ := splitStarted(w.Started)
+	return &model.WorklogResponse{
+		TempoWorklogID: w.TempoWorklogID,
+		JiraWorklogID:  w.JiraWorklogID,
+		Issue:          model.WorklogResponseIssue{ID: w.Issue.ID, Key: w.Issue.Key},
+		TimeSpent:      w.TimeSpent,
+		StartDate:      startDate,
+		Description:    w.Comment,
+	}
+}
+
+// splitStarted splits "2026-04-01 09:00:00.000" (or "2026-04-01T09:00:00.000") into date and time
+func splitStarted(started string) (date, clock string) {
+	if len(started) < 10 {
+		return started, ""
+	}
+	date = started[:10]
+	if len(started) >= 19 {
+		clock = started[11:19]
+	}
+	return date, clock
+}
+
+// serverWorklogBody builds the body of a create or update request
+func serverWorklogBody(issueID int, worker, startDate, startTime, description string, seconds int, billableSeconds *int, attributes []model.WorkAttributeValue) map[string]interface{} {
+	if startTime == "" {
+		startTime = "00:00:00"
+	}
+	body := map[string]interface{}{
+		"originTaskId":     strconv.Itoa(issueID),
+		"worker":           worker,
+		"started":          fmt.Sprintf("%sT%s.000", startDate, startTime),
+		"timeSpentSeconds": seconds,
+	}
+	if description != "" {
+		body["comment"] = description
+	}
+	if billableSeconds != nil {
+		body["billableSeconds"] = *billableSeconds
+	}
+	if len(attributes) > 0 {
+		values := make(map[string]interface{}, len(attributes))
+		for _, attribute := range attributes {
+			values[attribute.Key] = map[string]string{"value": attribute.Value}
+		}
+		body["attributes"] = values
+	}
+	return body
+}
+
+// searchWorklogs runs POST /worklogs/search, which returns every match in one response
+func (c tempoServer) searchWorklogs(ctx context.Context, query worklogQuery, onPage func([]model.Worklog) error) error {
+	requestBody := map[string]interface{}{
+		"from":   query.from,
+		"to":     query.to,
+		"worker": []string{query.authorID},
+	}
+
+	req, err := c.newRequest(ctx, "POST", c.baseURL+"/worklogs/search", requestBody)
+	if err != nil {
+		return err
+	}
+
+	// Searching only reads, so the POST is safe to retry
+	var results []serverWorklog
+	if err := c.send(markIdempotent(req), "failed to fetch worklogs", &results); err != nil {
+		return err
+	}
+
+	worklogs := make([]model.Worklog, len(results))
+	for i, result := range results {
+		worklogs[i] = result.toWorklog()
+	}
+	return onPage(worklogs)
+}
+
+// fetchWorklog fetches a worklog with GET /worklogs/{id}
+func (c tempoServer) fetchWorklog(ctx context.Context, worklogID int) (*model.Worklog, error) {
+	var result serverWorklog
+	endpoint := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+	if err := c.do(ctx, "GET", endpoint, nil, fmt.Sprintf("failed to fetch worklog %d", worklogID), &result); err != nil {
+		return nil, err
+	}
+	worklog := result.toWorklog()
+	return &worklog, nil
+}
+
+// createWorklog creates a worklog with POST /worklogs, which answers with the created worklogs
+func (c tempoServer) createWorklog(ctx context.Context, worklog model.WorklogRequest) (*model.WorklogResponse, error) {
+	body := serverWorklogBody(worklog.IssueID, worklog.AuthorAccountID, worklog.StartDate, worklog.StartTime, worklog.Description,
+		worklog.TimeSpentSeconds, worklog.BillableSeconds, worklog.Attributes)
+
+	var results []serverWorklog
+	if err := c.do(ctx, "POST", c.baseURL+"/worklogs", body, "failed to create worklog", &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("failed to create worklog: empty response")
+	}
+	return results[0].toResponse(), nil
+}
+
+// updateWorklog replaces a worklog with PUT /worklogs/{id}. Tempo Server needs the issue in the
+// body, so the worklog is fetched first; everything else, billable time and attributes included,
+// comes from the update (see model.NewWorklogUpdate).
+func (c tempoServer) updateWorklog(ctx context.Context, worklogID int, update model.WorklogUpdate) (*model.WorklogResponse, error) {
+	existing, err := c.fetchWorklog(ctx, worklogID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := serverWorklogBody(existing.Issue.ID, update.AuthorAccountID, update.StartDate, update.StartTime, update.Description,
+		update.TimeSpentSeconds, update.BillableSeconds, update.Attributes)
+
+	var result serverWorklog
+	endpoint := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+	if err := c.do(ctx, "PUT", endpoint, body, "failed to update worklog", &result); err != nil {
+		return nil, err
+	}
+	return result.toResponse(), nil
+}
+
+// deleteWorklog deletes a worklog with DELETE /worklogs/{id}
+func (c tempoServer) deleteWorklog(ctx context.Context, worklogID int) error {
+	endpoint := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+	return c.do(ctx, "DELETE", endpoint, nil, "failed to delete worklog", nil)
+}
+
+// fetchWorkAttributes fetches GET /work-attribute from Tempo Core
+func (c tempoServer) fetchWorkAttributes(ctx context.Context) ([]model.WorkAttribute, error) {
+	var results []struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+		Type struct {
+			Value string `json:"value"`
+		} `json:"type"`
+		Required         bool `json:"required"`
+		StaticListValues []struct {
+			Name    string `json:"name"`
+			Value   string `json:"value"`
+			Removed bool   `json:"removed"`
+		} `json:"staticListValues"`
+	}
+	if err := c.do(ctx, "GET", c.coreURL+"/work-attribute", nil, "failed to fetch work attributes", &results); err != nil {
+		return nil, err
+	}
+
+	attributes := make([]model.WorkAttribute, 0, len(results))
+	for _, result := range results {
+		attribute := model.WorkAttribute{Key: result.Key, Name: result.Name, Type: result.Type.Value, Required: result.Required}
+		for _, value := range result.StaticListValues {
+			if value.Removed {
+				continue
+			}
+			attribute.Values = append(attribute.Values, value.Value)
+			if attribute.Names == nil {
+				attribute.Names = make(map[string]string)
+			}
+			attribute.Names[value.Value] = value.Name
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes, nil
+}
+
+// fetchUserSchedule fetches GET /user/schedule from Tempo Core for the authenticated user
+func (c tempoServer) fetchUserSchedule(ctx context.Context, from, to string) ([]model.ScheduleDay, error) {
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", to)
+
+	var result struct {
+		Days []model.ScheduleDay `json:"days"`
+	}
+	if err := c.do(ctx, "GET", c.coreURL+"/user/schedule?"+query.Encode(), nil, "failed to fetch user schedule", &result); err != nil {
+		return nil, err
+	}
+
+	// Server reports holidays on weekends separately; they need no time either way
+	for i, day := range result.Days {
+		if day.Type == "HOLIDAY_AND_NON_WORKING_DAY" {
+			result.Days[i].Type = model.ScheduleHoliday
+		}
+	}
+	return result.Days, nil
+}
+
+// do sends a JSON request and decodes the response into out (if not nil)
+func (c tempoServer) do(ctx context.Context, method, endpoint string, body interface{}, op string, out interface{}) error {
+	req, err := c.newRequest(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	return c.send(req, op, out)
+}
+
+// newRequest builds an authenticated JSON request
+func (c tempoServer) newRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Request, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// send sends req and decodes the response into out (if not nil)
+func (c tempoServer) send(req *http.Request, op string, out interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newStatusError(op, resp)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// FetchWorklog retrieves a single worklog by its Tempo ID
+func (c *TempoClient) FetchWorklog(worklogID int) (*model.Worklog, error) {
+	return c.FetchWorklogContext(context.Background(), worklogID)
+}
+
+// FetchWorklogContext is like FetchWorklog but uses ctx for cancellation
+func (c *TempoClient) FetchWorklogContext(ctx context.Context, worklogID int) (*model.Worklog, error) {
+	return c.api.fetchWorklog(ctx, worklogID)
+}
+
+// fetchWorklog fetches a worklog with GET /worklogs/{id}
+func (c tempoCloud) fetchWorklog(ctx context.Context, worklogID int) (*model.Worklog, error) {
+	url := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch worklog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(fmt.Sprintf("failed to fetch worklog %d", worklogID), resp)
+	}
+
+	var worklog model.Worklog
+	if err := json.NewDecoder(resp.Body).Decode(&worklog); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &worklog, nil
+}
+
+// UpdateWorklog replaces an existing worklog with update, which should carry every field to keep
+func (c *TempoClient) UpdateWorklog(worklogID int, update model.WorklogUpdate) (*model.WorklogResponse, error) {
+	return c.UpdateWorklogContext(context.Background(), worklogID, update)
+}
+
+// UpdateWorklogContext is like UpdateWorklog but uses ctx for cancellation
+func (c *TempoClient) UpdateWorklogContext(ctx context.Context, worklogID int, update model.WorklogUpdate) (*model.WorklogResponse, error) {
+	return c.api.updateWorklog(ctx, worklogID, update)
+}
+
+// updateWorklog replaces a worklog with PUT /worklogs/{id}
+func (c tempoCloud) updateWorklog(ctx context.Context, worklogID int, update model.WorklogUpdate) (*model.WorklogResponse, error) {
+	url := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+
+	jsonData, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal worklog update: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update worklog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError("failed to update worklog", resp)
+	}
+
+	var worklogResp model.WorklogResponse
+	if err := json.NewDecoder(resp.Body).Decode(&worklogResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &worklogResp, nil
+}
+
+// DeleteWorklog deletes a worklog by its Tempo ID
+func (c *TempoClient) DeleteWorklog(worklogID int) error {
+	return c.DeleteWorklogContext(context.Background(), worklogID)
+}
+
+// DeleteWorklogContext is like DeleteWorklog but uses ctx for cancellation
+func (c *TempoClient) DeleteWorklogContext(ctx context.Context, worklogID int) error {
+	return c.api.deleteWorklog(ctx, worklogID)
+}
+
+// deleteWorklog deletes a worklog with DELETE /worklogs/{id}
+func (c tempoCloud) deleteWorklog(ctx context.Context, worklogID int) error {
+	url := fmt.Sprintf("%s/worklogs/%d", c.baseURL, worklogID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete worklog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return newStatusError("failed to delete worklog", resp)
+	}
+
+	return nil
+}
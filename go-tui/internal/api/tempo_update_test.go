@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestUpdateWorklogPutsWorklog(t *testing.T) {
+	var got model.WorklogUpdate
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/worklogs/42" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		fmt.Fprint(w, `{"tempoWorklogId":42,"timeSpentSeconds":5400}`)
+	}))
+	defer server.Close()
+
+	client := NewTempoClient("token", nil)
+	client.baseURL = server.URL
+
+	resp, err := client.UpdateWorklog(42, model.WorklogUpdate{TimeSpentSeconds: 5400, StartDate: "2026-04-01", AuthorAccountID: "acc"})
+	if err != nil {
+		t.Fatalf("UpdateWorklog() error = %v", err)
+	}
+	if resp.TempoWorklogID != 42 || got.TimeSpentSeconds != 5400 || got.AuthorAccountID != "acc" {
+		t.Errorf("unexpected update: sent %+v, got %+v", got, resp)
+	}
+}
+
+func TestTimeOnlyEditKeepsAttributes(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"tempoWorklogId":42,"timeSpentSeconds":3600,"billableSeconds":1800,"startDate":"2026-04-01",
+				"author":{"accountId":"acc"},"attributes":{"self":"x","values":[{"key":"_WorkType_","value":"Dev"}]}}`)
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			fmt.Fprint(w, `{"tempoWorklogId":42}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewTempoClient("token", nil)
+	client.baseURL = server.URL
+
+	existing, err := client.FetchWorklog(42)
+	if err != nil {
+		t.Fatalf("FetchWorklog() error = %v", err)
+	}
+	update := model.NewWorklogUpdate(*existing)
+	update.SetTimeSpent(5400)
+	if _, err := client.UpdateWorklog(42, update); err != nil {
+		t.Fatalf("UpdateWorklog() error = %v", err)
+	}
+
+	attributes, _ := got["attributes"].([]interface{})
+	if got["timeSpentSeconds"] != float64(5400) || got["billableSeconds"] != float64(1800) || len(attributes) != 1 {
+		t.Fatalf("update dropped fields: %v", got)
+	}
+	if attribute, _ := attributes[0].(map[string]interface{}); attribute["key"] != "_WorkType_" || attribute["value"] != "Dev" {
+		t.Errorf("attribute = %v, want _WorkType_=Dev", attributes[0])
+	}
+}
+
+func TestDeleteWorklog(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "no content", status: http.StatusNoContent},
+		{name: "not found", status: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/worklogs/7" {
+					t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := NewTempoClient("token", nil)
+			client.baseURL = server.URL
+
+			if err := client.DeleteWorklog(7); (err != nil) != tt.wantErr {
+				t.Errorf("DeleteWorklog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
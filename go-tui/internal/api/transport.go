@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Retry and concurrency settings of the transport shared by the Jira and Tempo clients
+const (
+	maxRetries            = 3
+	retryBaseDelay        = 500 * time.Millisecond
+	retryMaxDelay         = 8 * time.Second
+	maxRetryAfter         = time.Minute // Longer Retry-After waits fail with ErrRateLimited instead
+	maxConcurrentRequests = 8
+	requestTimeout        = 2 * time.Minute // Per request, including retries
+)
+
+// sharedTransport is used by every Jira and Tempo client, so the concurrency cap is global
+var sharedTransport = newRetryTransport(&http.Transport{
+	Proxy:                 http.ProxyFromEnvironment,
+	MaxIdleConns:          100,
+	MaxIdleConnsPerHost:   20,
+	IdleConnTimeout:       90 * time.Second,
+	ResponseHeaderTimeout: 30 * time.Second,
+	DisableCompression:    false,
+})
+
+// newHTTPClient creates an HTTP client that uses the shared retrying transport
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: sharedTransport,
+		Timeout:   requestTimeout,
+	}
+}
+
+// newOAuthHTTPClient creates an HTTP client that adds a Bearer token from source to every
+// request, on top of the shared retrying transport
+func newOAuthHTTPClient(source oauth2.TokenSource) *http.Client {
+	return &http.Client{
+		Transport: &oauth2.Transport{Source: source, Base: sharedTransport},
+		Timeout:   requestTimeout,
+	}
+}
+
+// retryTransport retries failed requests with exponential backoff and limits how many
+// requests are in flight at once. Requests rejected with 429 are always retried (the server
+// did not process them), honouring Retry-After; network errors and 502/503/504 responses
+// are only retried for idempotent requests.
+type retryTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newRetryTransport wraps base with retries and the concurrency cap
+func newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		base:  base,
+		slots: make(chan struct{}, maxConcurrentRequests),
+		sleep: sleepContext,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-t.slots }()
+
+	// A body can only be sent again if it can be recreated
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		delay, retry := retryDelay(req, resp, err, attempt)
+		if !retry || attempt >= maxRetries || !replayable || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+			resp.Body.Close()
+		}
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay decides whether a request should be retried and how long to wait first
+func retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil {
+		return backoff(attempt), isIdempotent(req)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if !ok {
+			return backoff(attempt), true
+		}
+		return wait, wait <= maxRetryAfter
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && wait <= maxRetryAfter {
+			return wait, isIdempotent(req)
+		}
+		return backoff(attempt), isIdempotent(req)
+	}
+	return 0, false
+}
+
+// backoff returns the exponential delay before the given retry, with up to 50% jitter
+func backoff(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+type idempotentKey struct{}
+
+// markIdempotent marks a POST that only reads data (e.g. a search) as safe to retry
+func markIdempotent(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), idempotentKey{}, true))
+}
+
+// isIdempotent reports whether sending the request twice has the same effect as once
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	marked, _ := req.Context().Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// sleepContext waits for d or until ctx is cancelled
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
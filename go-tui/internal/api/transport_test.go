@@ -0,0 +1,136 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestRetryTransport answers with the given status codes in order and records sleeps
+func newTestRetryTransport(t *testing.T, statuses []int, header http.Header) (*retryTransport, *[]string, *[]time.Duration) {
+	var bodies []string
+	var sleeps []time.Duration
+	transport := newRetryTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if len(bodies) >= len(statuses) {
+			t.Fatalf("unexpected request #%d", len(bodies)+1)
+		}
+		body := ""
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			body = string(data)
+		}
+		bodies = append(bodies, body)
+		status := statuses[len(bodies)-1]
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Header: header, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+	}))
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		return nil
+	}
+	return transport, &bodies, &sleeps
+}
+
+func TestRetryTransportHonoursRetryAfterOn429(t *testing.T) {
+	transport, bodies, sleeps := newTestRetryTransport(t, []int{429, 201}, http.Header{"Retry-After": {"2"}})
+
+	req, _ := http.NewRequest("POST", "https://api.tempo.io/4/worklogs", bytes.NewBufferString(`{"issueId":"1"}`))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	if resp.StatusCode != 201 {
+		t.Errorf("expected the retry to succeed, got %d", resp.StatusCode)
+	}
+	if len(*bodies) != 2 || (*bodies)[1] != `{"issueId":"1"}` {
+		t.Errorf("expected the body to be sent again, got %q", *bodies)
+	}
+	if len(*sleeps) != 1 || (*sleeps)[0] != 2*time.Second {
+		t.Errorf("expected to wait for Retry-After, got %v", *sleeps)
+	}
+}
+
+func TestRetryTransportRetriesOnlyIdempotentRequestsOnBadGateway(t *testing.T) {
+	transport, bodies, _ := newTestRetryTransport(t, []int{502}, nil)
+	req, _ := http.NewRequest("POST", "https://api.tempo.io/4/worklogs", bytes.NewBufferString(`{}`))
+	if resp, _ := transport.RoundTrip(req); resp.StatusCode != 502 || len(*bodies) != 1 {
+		t.Errorf("expected a write not to be retried, got %d after %d requests", resp.StatusCode, len(*bodies))
+	}
+
+	transport, bodies, sleeps := newTestRetryTransport(t, []int{502, 503, 504, 502}, nil)
+	req, _ = http.NewRequest("POST", "https://example.atlassian.net/rest/api/3/search/jql", bytes.NewBufferString(`{}`))
+	resp, _ := transport.RoundTrip(markIdempotent(req))
+	if resp.StatusCode != 502 || len(*bodies) != maxRetries+1 {
+		t.Errorf("expected a search to be retried %d times, got %d requests", maxRetries, len(*bodies))
+	}
+	for i, sleep := range *sleeps {
+		if min := retryBaseDelay << i; sleep < min {
+			t.Errorf("retry %d waited %v, want at least %v", i+1, sleep, min)
+		}
+	}
+}
+
+func TestStatusErrorsMatchTypedErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errorMessages":["Issue does not exist"]}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewJiraClient(server.URL, "user", "token").FetchIssue("GRAP-404")
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a StatusError, got %#v", err)
+	}
+	if !errors.Is(&StatusError{StatusCode: 401}, ErrUnauthorized) || !errors.Is(&StatusError{StatusCode: 429}, ErrRateLimited) {
+		t.Error("expected 401 and 429 to match their typed errors")
+	}
+}
+
+// sequenceTokenSource hands out a new access token on every call
+type sequenceTokenSource struct{ calls int }
+
+func (s *sequenceTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", s.calls), TokenType: "Bearer"}, nil
+}
+
+func TestOAuthJiraClientUsesCurrentToken(t *testing.T) {
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"accountId":"abc"}`))
+	}))
+	defer server.Close()
+
+	source := &sequenceTokenSource{}
+	client := &JiraClient{baseURL: server.URL, tokenSource: source, client: newOAuthHTTPClient(source)}
+	for i := 0; i < 2; i++ {
+		if _, err := client.FetchUser("abc"); err != nil {
+			t.Fatalf("FetchUser() error = %v", err)
+		}
+	}
+
+	want := []string{"Bearer token-1", "Bearer token-2"}
+	if len(auths) != 2 || auths[0] != want[0] || auths[1] != want[1] {
+		t.Errorf("Authorization headers = %v, want %v", auths, want)
+	}
+}
@@ -0,0 +1,165 @@
+// Package cache keeps the last synced issues and worklogs on disk so the TUI can show
+// them instantly on startup (or while offline) and refresh them incrementally.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// snapshotVersion changes whenever the snapshot format does; older snapshots are ignored
+const snapshotVersion = 2
+
+// MaxIncrementalAge is how old a snapshot may be before a full sync replaces it
+const MaxIncrementalAge = 7 * 24 * time.Hour
+
+// Snapshot is the data saved after a successful sync
+type Snapshot struct {
+	Version  int             `json:"version"`
+	Site     string          `json:"site"`
+	Username string          `json:"username"`
+	SyncedAt time.Time       `json:"syncedAt"` // When the sync started; later changes are fetched on the next sync
+	User     *model.User     `json:"user"`
+	Issues   []model.Issue   `json:"issues"`
+	Worklogs []model.Worklog `json:"worklogs"`
+}
+
+// Incremental reports whether the snapshot is recent enough to only fetch changes since it
+func (s *Snapshot) Incremental() bool {
+	return s != nil && time.Since(s.SyncedAt) < MaxIncrementalAge
+}
+
+// Store persists the snapshot to disk
+type Store struct {
+	path string
+}
+
+// NewStore creates a store for a configuration profile in the user cache directory
+// ($XDG_CACHE_HOME/jira-daily-report/snapshot.json on Linux, snapshot-<profile>.json for
+// named profiles), so switching profiles keeps each profile's snapshot
+func NewStore(profile string) (*Store, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache directory: %w", err)
+	}
+	name := "snapshot.json"
+	if profile != "" && profile != "default" {
+		if strings.ContainsAny(profile, `/\`) {
+			return nil, fmt.Errorf("invalid profile name %q", profile)
+		}
+		name = "snapshot-" + profile + ".json"
+	}
+	return NewStoreAt(filepath.Join(cacheDir, "jira-daily-report", name)), nil
+}
+
+// NewStoreAt creates a store backed by the given file
+func NewStoreAt(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the snapshot file location
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load returns the snapshot saved for the site and user, or nil if there is none
+func (s *Store) Load(site, username string) (*Snapshot, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse cache: %w", err)
+	}
+	if snapshot.Version != snapshotVersion || snapshot.Site != site || snapshot.Username != username {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+// Save replaces the saved snapshot
+func (s *Store) Save(snapshot Snapshot) error {
+	snapshot.Version = snapshotVersion
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	// Write to a temporary file first so a crash never leaves a truncated snapshot
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	return nil
+}
+
+// Clear deletes the saved snapshot
+func (s *Store) Clear() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
+
+// MergeIssues applies issues changed since the snapshot: updated issues replace cached ones
+// with the same key (or are added) and removed keys are dropped
+func MergeIssues(cached, updated []model.Issue, removedKeys []string) []model.Issue {
+	drop := make(map[string]bool, len(removedKeys)+len(updated))
+	for _, key := range removedKeys {
+		drop[key] = true
+	}
+	for _, issue := range updated {
+		drop[issue.Key] = true
+	}
+
+	merged := make([]model.Issue, 0, len(cached)+len(updated))
+	for _, issue := range cached {
+		if !drop[issue.Key] {
+			merged = append(merged, issue)
+		}
+	}
+	return append(merged, updated...)
+}
+
+// MergeWorklogs applies worklogs changed since the snapshot, replacing cached ones with the
+// same Tempo ID, and keeps only worklogs dated between from and to (inclusive)
+func MergeWorklogs(cached, updated []model.Worklog, from, to string) []model.Worklog {
+	replaced := make(map[int]bool, len(updated))
+	for _, worklog := range updated {
+		replaced[worklog.TempoWorklogID] = true
+	}
+
+	merged := make([]model.Worklog, 0, len(cached)+len(updated))
+	for _, worklog := range cached {
+		if !replaced[worklog.TempoWorklogID] {
+			merged = append(merged, worklog)
+		}
+	}
+	merged = append(merged, updated...)
+
+	inRange := merged[:0]
+	for _, worklog := range merged {
+		if worklog.StartDate >= from && worklog.StartDate <= to {
+			inRange = append(inRange, worklog)
+		}
+	}
+	return inRange
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestStoreLoadsSnapshotForSameSiteAndUser(t *testing.T) {
+	store := NewStoreAt(filepath.Join(t.TempDir(), "cache", "snapshot.json"))
+
+	if snapshot, err := store.Load("https://a.atlassian.net", "me@example.com"); err != nil || snapshot != nil {
+		t.Fatalf("Load() without a cache = %v, %v", snapshot, err)
+	}
+
+	err := store.Save(Snapshot{
+		Site:     "https://a.atlassian.net",
+		Username: "me@example.com",
+		SyncedAt: time.Now().Add(-time.Hour),
+		Issues:   []model.Issue{{Key: "GRAP-1"}},
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	snapshot, err := store.Load("https://a.atlassian.net", "me@example.com")
+	if err != nil || snapshot == nil {
+		t.Fatalf("Load() = %v, %v", snapshot, err)
+	}
+	if len(snapshot.Issues) != 1 || !snapshot.Incremental() {
+		t.Errorf("unexpected snapshot: %+v", snapshot)
+	}
+
+	if other, _ := store.Load("https://b.atlassian.net", "me@example.com"); other != nil {
+		t.Error("expected a snapshot for another site to be ignored")
+	}
+}
+
+func TestNewStorePerProfile(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	defaultStore, err := NewStore("default")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	namedStore, err := NewStore("client-b")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	if filepath.Base(defaultStore.Path()) != "snapshot.json" || filepath.Base(namedStore.Path()) != "snapshot-client-b.json" {
+		t.Errorf("unexpected paths %s and %s", defaultStore.Path(), namedStore.Path())
+	}
+
+	if _, err := NewStore("../escape"); err == nil {
+		t.Error("expected an error for a profile name with a path separator")
+	}
+}
+
+func TestSnapshotIncremental(t *testing.T) {
+	var missing *Snapshot
+	if missing.Incremental() {
+		t.Error("expected no snapshot to need a full sync")
+	}
+	stale := &Snapshot{SyncedAt: time.Now().Add(-MaxIncrementalAge - time.Hour)}
+	if stale.Incremental() {
+		t.Error("expected a stale snapshot to need a full sync")
+	}
+}
+
+func TestMergeIssues(t *testing.T) {
+	cached := []model.Issue{{Key: "A-1"}, {Key: "A-2"}, {Key: "A-3"}}
+	updated := []model.Issue{{Key: "A-2", Fields: model.IssueFields{Summary: "renamed"}}, {Key: "A-4"}}
+
+	merged := MergeIssues(cached, updated, []string{"A-3"})
+
+	var keys []string
+	for _, issue := range merged {
+		keys = append(keys, issue.Key)
+	}
+	if want := "A-1 A-2 A-4"; strings.Join(keys, " ") != want {
+		t.Fatalf("MergeIssues() keys = %v, want %s", keys, want)
+	}
+	if merged[1].Fields.Summary != "renamed" {
+		t.Errorf("expected the updated issue to replace the cached one, got %+v", merged[1])
+	}
+}
+
+func TestMergeWorklogs(t *testing.T) {
+	cached := []model.Worklog{
+		{TempoWorklogID: 1, StartDate: "2026-03-30", TimeSpentSeconds: 3600},
+		{TempoWorklogID: 2, StartDate: "2026-04-01", TimeSpentSeconds: 3600},
+	}
+	updated := []model.Worklog{
+		{TempoWorklogID: 2, StartDate: "2026-04-01", TimeSpentSeconds: 7200},
+		{TempoWorklogID: 3, StartDate: "2026-04-02", TimeSpentSeconds: 1800},
+	}
+
+	merged := MergeWorklogs(cached, updated, "2026-03-31", "2026-04-02")
+
+	if len(merged) != 2 || merged[0].TempoWorklogID != 2 || merged[0].TimeSpentSeconds != 7200 || merged[1].TempoWorklogID != 3 {
+		t.Errorf("MergeWorklogs() = %+v", merged)
+	}
+}
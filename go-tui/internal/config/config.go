@@ -1,16 +1,28 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"golang.org/x/oauth2"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
 	"github.com/yourusername/jira-daily-report/internal/oauth"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+	"github.com/yourusername/jira-daily-report/internal/timesheet"
 )
 
+// DefaultWhoAmI is the whoAmI used when none is configured
+const DefaultWhoAmI = "Developer"
+
 // Config holds the application configuration
 type Config struct {
+	// Deployment is "cloud" (default) or "server" for Jira Server/Data Center
+	Deployment    string `json:"deployment,omitempty"`
 	JiraServer    string `json:"jiraServer"`
 	Username      string `json:"username"`
 	ApiToken      string `json:"apiToken"`
@@ -18,114 +30,200 @@ type Config struct {
 	WhoAmI        string `json:"whoAmI"`
 	AutoClipboard bool   `json:"autoClipboard"`
 	Theme         string `json:"theme"`
+	// ReportTemplate is an optional path to a text/template file for the text report
+	ReportTemplate string `json:"reportTemplate,omitempty"`
+	// BlockedStatuses are the status names that mark an issue as blocked
+	BlockedStatuses []string `json:"blockedStatuses,omitempty"`
+	// FlaggedField is the ID of the "Flagged" custom field; empty looks it up by name
+	FlaggedField string `json:"flaggedField,omitempty"`
+	// StatusMapping assigns workflow status names to panels; empty groups use the defaults
+	StatusMapping model.StatusMapping `json:"statusMapping,omitempty"`
+	// TimerRounding controls how stopped timers are rounded into worklogs
+	TimerRounding timer.Rounding `json:"timerRounding,omitempty"`
+	// Timesheet sets the required hours per workday used for gap detection
+	Timesheet timesheet.Settings `json:"timesheet,omitempty"`
+	// OAuthSite is the Atlassian Cloud site chosen for OAuth, with its cached cloud ID
+	OAuthSite *Site `json:"oauthSite,omitempty"`
+}
+
+// Site identifies an Atlassian Cloud site used with OAuth
+type Site struct {
+	CloudID string `json:"cloudId"`
+	URL     string `json:"url"`
+	Name    string `json:"name,omitempty"`
+}
+
+// configFileName is the name of the configuration file in the home directory
+const configFileName = ".jira-daily-report.json"
+
+// envOverrides are the environment variables that override settings, in the order they are
+// applied (a later variable wins over an earlier one for the same setting)
+var envOverrides = []struct{ env, key string }{
+	{"JIRA_DEPLOYMENT", "deployment"},
+	{"JIRA_SERVER", "jiraServer"},
+	{"JIRA_EMAIL", "username"},
+	{"JIRA_USERNAME", "username"},
+	{"JIRA_API_TOKEN", "apiToken"},
+	{"TEMPO_API_TOKEN", "tempoApiToken"},
+	{"JIRA_ACCOUNT_ID", "whoAmI"},
+	{"JIRA_WHOAMI", "whoAmI"},
+	{"JIRA_THEME", "theme"},
+	{"JIRA_REPORT_TEMPLATE", "reportTemplate"},
+	{"JIRA_BLOCKED_STATUSES", "blockedStatuses"},
+	{"JIRA_REQUIRED_HOURS", "timesheet.requiredHours"},
 }
 
+// defaultBlockedStatuses is used when no blocked statuses are configured
+var defaultBlockedStatuses = []string{"Blocked"}
+
 // Manager handles configuration loading and access
 type Manager struct {
-	config *Config
+	config  *Config
+	profile string
 }
 
-// NewManager creates a new configuration manager
+// NewManager creates a new configuration manager for the active profile
 func NewManager() (*Manager, error) {
-	config, err := loadConfig()
+	profile := ActiveProfile()
+	config, err := loadConfig(profile)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("profile %q: %w", profile, err)
 	}
-	return &Manager{config: config}, nil
+	return &Manager{config: config, profile: profile}, nil
 }
 
-// loadConfig reads the configuration from ~/.jira-daily-report.json or environment variables
-func loadConfig() (*Config, error) {
-	homeDir, err := os.UserHomeDir()
+// loadConfig reads the configuration of a profile from ~/.jira-daily-report.json or environment variables
+func loadConfig(profile string) (*Config, error) {
+	config, err := readConfig(profile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return nil, err
 	}
+	if err := validateConfig(config, profile); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
 
-	configPath := filepath.Join(homeDir, ".jira-daily-report.json")
+// readConfig reads the configuration of a profile, with environment overrides applied and
+// secrets resolved, without validating it
+func readConfig(profile string) (*Config, error) {
+	data, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
 
 	// Try to load from file first
 	var config Config
-	if data, err := os.ReadFile(configPath); err == nil {
+	if data != nil {
 		if err := json.Unmarshal(data, &config); err != nil {
 			return nil, fmt.Errorf("failed to parse config file: %w", err)
 		}
+		if err := applyProfile(&config, data, profile); err != nil {
+			return nil, err
+		}
+	} else if profile != DefaultProfile {
+		return nil, fmt.Errorf("profile %q not found (see 'jira-report config list')", profile)
 	}
 
 	// Override with environment variables if present
-	if val := os.Getenv("JIRA_SERVER"); val != "" {
-		config.JiraServer = val
-	}
-	if val := os.Getenv("JIRA_EMAIL"); val != "" {
-		config.Username = val
-	}
-	if val := os.Getenv("JIRA_USERNAME"); val != "" {
-		config.Username = val
-	}
-	if val := os.Getenv("JIRA_API_TOKEN"); val != "" {
-		config.ApiToken = val
-	}
-	if val := os.Getenv("TEMPO_API_TOKEN"); val != "" {
-		config.TempoApiToken = val
+	for _, override := range envOverrides {
+		if val := os.Getenv(override.env); val != "" {
+			if err := setSetting(&config, override.key, val); err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", override.env, val, err)
+			}
+		}
 	}
-	if val := os.Getenv("JIRA_ACCOUNT_ID"); val != "" {
-		config.WhoAmI = val
+
+	// API tokens saved by "config init" or "config migrate-secrets" are references to secret storage
+	if err := resolveSecrets(&config); err != nil {
+		return nil, err
 	}
-	if val := os.Getenv("JIRA_WHOAMI"); val != "" {
-		config.WhoAmI = val
+
+	return &config, nil
+}
+
+// validateConfig checks the required settings of a profile's configuration and fills in defaults
+func validateConfig(config *Config, profile string) error {
+	if err := config.TimerRounding.Validate(); err != nil {
+		return fmt.Errorf("invalid timerRounding: %w", err)
 	}
-	if val := os.Getenv("JIRA_THEME"); val != "" {
-		config.Theme = val
+
+	deployment, err := model.ParseDeployment(config.Deployment)
+	if err != nil {
+		return fmt.Errorf("invalid deployment: %w", err)
 	}
 
 	// Validate required fields
 	if config.JiraServer == "" {
-		return nil, fmt.Errorf("JIRA_SERVER is required (set via config file or environment variable)")
+		return fmt.Errorf("JIRA_SERVER is required (set via config file or environment variable)")
 	}
 
-	// Try to load OAuth token from keyring
-	oauthToken := loadOAuthToken()
+	// Server/Data Center uses a personal access token for both Jira and Tempo, and usernames as identities
+	if deployment == model.DeploymentServer {
+		if config.ApiToken == "" {
+			return fmt.Errorf("JIRA_API_TOKEN (a personal access token) is required for Jira Server/Data Center")
+		}
+		if config.WhoAmI == "" {
+			config.WhoAmI = config.Username
+		}
+		if config.WhoAmI == "" {
+			config.WhoAmI = DefaultWhoAmI
+		}
+		return nil
+	}
 
-	// If OAuth is available, username and apiToken are optional
-	if oauthToken == "" {
+	// If an OAuth token is stored in the keyring, username and apiToken are optional
+	if loadOAuthTokenSource(profile) == nil {
 		if config.Username == "" {
-			return nil, fmt.Errorf("JIRA_EMAIL/JIRA_USERNAME is required (set via config file or environment variable)")
+			return fmt.Errorf("JIRA_EMAIL/JIRA_USERNAME is required (set via config file or environment variable)")
 		}
 		if config.ApiToken == "" {
-			return nil, fmt.Errorf("JIRA_API_TOKEN is required (set via config file or environment variable)")
+			return fmt.Errorf("JIRA_API_TOKEN is required (set via config file or environment variable)")
 		}
 	}
 
 	if config.TempoApiToken == "" {
-		return nil, fmt.Errorf("TEMPO_API_TOKEN is required (set via config file or environment variable)")
+		return fmt.Errorf("TEMPO_API_TOKEN is required (set via config file or environment variable)")
 	}
 
 	// Set default for WhoAmI if not provided
 	if config.WhoAmI == "" {
-		config.WhoAmI = "Developer"
+		config.WhoAmI = DefaultWhoAmI
 	}
 
-	return &config, nil
+	return nil
 }
 
-// loadOAuthToken attempts to load OAuth token from keyring
-func loadOAuthToken() string {
-	// Create a token store and try to load the token
-	store := oauth.NewTokenStore()
-
-	// Try to get a valid token (will refresh if needed)
-	// We need a config to refresh, but we'll just try to load the stored token
+// loadOAuthTokenSource returns a refreshing source for the profile's OAuth token in the keyring,
+// or nil if there is no token or it has expired and cannot be refreshed
+func loadOAuthTokenSource(profile string) oauth2.TokenSource {
+	store := profileTokenStore(profile)
 	token, err := store.LoadToken()
 	if err != nil {
-		return "" // No OAuth token available
+		return nil // No OAuth token available
 	}
 
-	// Check if token is still valid (not expired)
-	// If expired and we can't refresh without config, return empty
-	if token.Valid() {
-		return token.AccessToken
+	// Refreshing needs the OAuth app credentials; without them the token is only good until it expires
+	oauthConfig, err := oauth.LoadConfig()
+	if err != nil {
+		oauthConfig = nil
+	}
+	if !token.Valid() && (token.RefreshToken == "" || oauthConfig == nil) {
+		return nil // Token expired and cannot be refreshed
 	}
 
-	return "" // Token expired or invalid
+	return store.TokenSource(context.Background(), oauthConfig)
+}
+
+// GetProfile returns the name of the loaded profile
+func (m *Manager) GetProfile() string {
+	return m.profile
+}
+
+// GetDeployment returns the Jira deployment type (Cloud unless configured otherwise)
+func (m *Manager) GetDeployment() model.Deployment {
+	deployment, _ := model.ParseDeployment(m.config.Deployment) // Validated on load
+	return deployment
 }
 
 // GetJiraServer returns the Jira server URL
@@ -153,9 +251,10 @@ func (m *Manager) GetWhoAmI() string {
 	return m.config.WhoAmI
 }
 
-// GetOAuthToken returns the OAuth Bearer token if available
-func (m *Manager) GetOAuthToken() string {
-	return loadOAuthToken()
+// GetOAuthTokenSource returns a source of OAuth Bearer tokens that refreshes and persists them,
+// or nil if OAuth is not available
+func (m *Manager) GetOAuthTokenSource() oauth2.TokenSource {
+	return loadOAuthTokenSource(m.profile)
 }
 
 // GetTheme returns the theme setting
@@ -171,7 +270,175 @@ func (m *Manager) GetAutoClipboard() bool {
 	return m.config.AutoClipboard
 }
 
+// GetReportTemplate returns the report template path with "~" expanded, or empty if unset
+func (m *Manager) GetReportTemplate() string {
+	return expandHome(m.config.ReportTemplate)
+}
+
+// GetFlaggedField returns the configured ID of the Flagged custom field, or "" to look it up
+func (m *Manager) GetFlaggedField() string {
+	return m.config.FlaggedField
+}
+
+// GetBlockedStatuses returns the status names treated as blocked
+func (m *Manager) GetBlockedStatuses() []string {
+	if len(m.config.BlockedStatuses) == 0 {
+		return defaultBlockedStatuses
+	}
+	return m.config.BlockedStatuses
+}
+
+// GetStatusMapping returns the status-to-panel mapping, with defaults for unset groups
+func (m *Manager) GetStatusMapping() model.StatusMapping {
+	return m.config.StatusMapping.WithDefaults()
+}
+
+// GetTimerRounding returns the rounding rule for stopped timers, with defaults for unset fields
+func (m *Manager) GetTimerRounding() timer.Rounding {
+	return m.config.TimerRounding.WithDefaults()
+}
+
+// GetTimesheetSettings returns the required-hours settings, with defaults for unset fields
+func (m *Manager) GetTimesheetSettings() timesheet.Settings {
+	return m.config.Timesheet.WithDefaults()
+}
+
+// GetOAuthSite returns the OAuth site chosen for the Jira server, or nil if none was chosen
+// (or it was chosen for a different server)
+func (m *Manager) GetOAuthSite() *Site {
+	site := m.config.OAuthSite
+	if site == nil || site.CloudID == "" || !oauth.SameSiteURL(site.URL, m.config.JiraServer) {
+		return nil
+	}
+	return site
+}
+
+// ResolveCloudID returns the cloud ID of the Jira server for OAuth requests. The ID is looked
+// up in the accessible resources of the OAuth token the first time and cached in the config file.
+func (m *Manager) ResolveCloudID(ctx context.Context, source oauth2.TokenSource) (string, error) {
+	site, cached, err := m.LookupOAuthSite(ctx, source)
+	if err != nil {
+		return "", err
+	}
+	if !cached {
+		m.config.OAuthSite = site
+		// A failed cache write only costs another lookup next time
+		_ = updateConfigFile(m.profile, map[string]interface{}{"oauthSite": site})
+	}
+	return site.CloudID, nil
+}
+
+// LookupOAuthSite returns the cached OAuth site of the Jira server, or else looks it up in the
+// accessible resources of the OAuth token without caching it. cached reports which one it is.
+func (m *Manager) LookupOAuthSite(ctx context.Context, source oauth2.TokenSource) (site *Site, cached bool, err error) {
+	if site := m.GetOAuthSite(); site != nil {
+		return site, true, nil
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		return nil, false, err
+	}
+	found, err := oauth.NewAtlassianClient(token).FindSiteByURL(ctx, m.config.JiraServer)
+	if err != nil {
+		return nil, false, err
+	}
+	return &Site{CloudID: found.ID, URL: found.URL, Name: found.Name}, false, nil
+}
+
+// SaveOAuthSite makes site the Jira server of the active profile and caches its cloud ID
+func SaveOAuthSite(site Site) error {
+	return updateConfigFile(ActiveProfile(), map[string]interface{}{
+		"jiraServer": site.URL,
+		"oauthSite":  site,
+	})
+}
+
+// ConfiguredJiraServer returns the Jira server of the active profile from the environment or
+// config file without validating the rest of the configuration
+func ConfiguredJiraServer() string {
+	if val := os.Getenv("JIRA_SERVER"); val != "" {
+		return val
+	}
+	var config Config
+	if data, err := readConfigFile(); err == nil && data != nil {
+		json.Unmarshal(data, &config)
+		applyProfile(&config, data, ActiveProfile())
+	}
+	return config.JiraServer
+}
+
+// updateConfigFile sets keys of a profile in the config file (top-level keys for the default
+// profile), keeping every other key (such as the buddy settings) as saved and without writing
+// environment overrides back. Nil values remove their key.
+func updateConfigFile(profile string, values map[string]interface{}) error {
+	configPath, err := configFilePath()
+	if err != nil {
+		return err
+	}
+
+	raw := map[string]interface{}{}
+	if data, err := os.ReadFile(configPath); err == nil {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("failed to parse config file: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	target := raw
+	if profile != DefaultProfile {
+		profiles, _ := raw["profiles"].(map[string]interface{})
+		if profiles == nil {
+			profiles = map[string]interface{}{}
+			raw["profiles"] = profiles
+		}
+		target, _ = profiles[profile].(map[string]interface{})
+		if target == nil {
+			target = map[string]interface{}{}
+			profiles[profile] = target
+		}
+	}
+	for key, value := range values {
+		if value == nil {
+			delete(target, key)
+		} else {
+			target[key] = value
+		}
+	}
+
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
 // GetConfig returns the underlying configuration
 func (m *Manager) GetConfig() *Config {
 	return m.config
 }
+
+// expandHome replaces a leading "~/" with the user's home directory
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(homeDir, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
+
+// splitList splits a comma-separated value, trimming blanks
+func splitList(val string) []string {
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -2,17 +2,15 @@ package config
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 	"syscall"
 
 	"golang.org/x/term"
 )
 
-// InitInteractive runs an interactive configuration setup
+// InitInteractive runs an interactive configuration setup for the active profile
 func InitInteractive() error {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -20,6 +18,70 @@ func InitInteractive() error {
 	fmt.Println("==========================================")
 	fmt.Println()
 
+	profile := ActiveProfile()
+	if profile != DefaultProfile {
+		fmt.Printf("Profile: %s\n\n", profile)
+	}
+
+	config := promptConfig(reader)
+
+	configPath, err := configFilePath()
+	if err != nil {
+		return err
+	}
+
+	// Check if file exists
+	if _, err := os.Stat(configPath); err == nil {
+		fmt.Printf("\n⚠️  Config file already exists at: %s\n", configPath)
+		fmt.Print("Overwrite? (y/N): ")
+		confirm, _ := reader.ReadString('\n')
+		if strings.ToLower(strings.TrimSpace(confirm)) != "y" {
+			fmt.Println("❌ Cancelled")
+			return nil
+		}
+	}
+
+	// Other profiles and settings in the file are kept
+	if err := saveProfile(profile, config); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Config saved to: %s\n", configPath)
+	if warning := SecretsFileWarning(profileSecretsBackend(profile)); warning != "" {
+		fmt.Printf("\n%s\n", warning)
+	}
+	fmt.Println("\n🚀 You can now run: jira-report tui")
+
+	return nil
+}
+
+// AddProfileInteractive prompts for the settings of a new named profile and saves it
+func AddProfileInteractive(name string) error {
+	if err := checkProfileName(name); err != nil {
+		return err
+	}
+	if err := checkProfileExists(name); err == nil {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+
+	fmt.Printf("🔧 New profile: %s\n", name)
+	fmt.Println("==========================================")
+	fmt.Println()
+
+	if err := AddProfile(name, promptConfig(bufio.NewReader(os.Stdin))); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Profile %s saved\n", name)
+	if warning := SecretsFileWarning(profileSecretsBackend(name)); warning != "" {
+		fmt.Printf("\n%s\n", warning)
+	}
+	fmt.Printf("\n🚀 Use it with: jira-report --profile %s tui (or: jira-report config use %s)\n", name, name)
+	return nil
+}
+
+// promptConfig asks for the connection settings
+func promptConfig(reader *bufio.Reader) Config {
 	// Jira Server
 	fmt.Print("Jira Server URL (e.g., https://your-domain.atlassian.net): ")
 	jiraServer, _ := reader.ReadString('\n')
@@ -55,8 +117,7 @@ func InitInteractive() error {
 		theme = "dark"
 	}
 
-	// Create config
-	config := Config{
+	return Config{
 		JiraServer:    jiraServer,
 		Username:      username,
 		ApiToken:      apiToken,
@@ -65,59 +126,33 @@ func InitInteractive() error {
 		AutoClipboard: false,
 		Theme:         theme,
 	}
-
-	// Save config
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	configPath := filepath.Join(homeDir, ".jira-daily-report.json")
-
-	// Check if file exists
-	if _, err := os.Stat(configPath); err == nil {
-		fmt.Printf("\n⚠️  Config file already exists at: %s\n", configPath)
-		fmt.Print("Overwrite? (y/N): ")
-		confirm, _ := reader.ReadString('\n')
-		if strings.ToLower(strings.TrimSpace(confirm)) != "y" {
-			fmt.Println("❌ Cancelled")
-			return nil
-		}
-	}
-
-	data, err := json.MarshalIndent(config, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
-	}
-
-	if err := os.WriteFile(configPath, data, 0600); err != nil {
-		return fmt.Errorf("failed to write config file: %w", err)
-	}
-
-	fmt.Printf("\n✅ Config saved to: %s\n", configPath)
-	fmt.Println("\n🚀 You can now run: jira-report tui")
-
-	return nil
 }
 
 // ShowConfig displays the current configuration (excluding sensitive data)
 func ShowConfig() error {
-	cfg, err := loadConfig()
+	profile := ActiveProfile()
+	cfg, err := loadConfig(profile)
 	if err != nil {
 		return err
 	}
 
 	fmt.Println("📋 Current Configuration")
 	fmt.Println("=======================")
+	fmt.Printf("Profile:      %s\n", profile)
+	if cfg.Deployment != "" {
+		fmt.Printf("Deployment:   %s\n", cfg.Deployment)
+	}
 	fmt.Printf("Jira Server:  %s\n", cfg.JiraServer)
 	fmt.Printf("Username:     %s\n", cfg.Username)
 	fmt.Printf("API Token:    %s\n", maskToken(cfg.ApiToken))
 	fmt.Printf("Tempo Token:  %s\n", maskToken(cfg.TempoApiToken))
 	fmt.Printf("Account ID:   %s\n", cfg.WhoAmI)
 	fmt.Printf("Theme:        %s\n", cfg.Theme)
+	if cfg.ReportTemplate != "" {
+		fmt.Printf("Template:     %s\n", cfg.ReportTemplate)
+	}
 
-	homeDir, _ := os.UserHomeDir()
-	configPath := filepath.Join(homeDir, ".jira-daily-report.json")
+	configPath, _ := configFilePath()
 	fmt.Printf("\nLocation: %s\n", configPath)
 
 	return nil
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/jira-daily-report/internal/oauth"
+)
+
+// DefaultProfile is the profile made of the top-level settings of the config file
+const DefaultProfile = "default"
+
+// profileOverride is the profile chosen with --profile
+var profileOverride string
+
+// profileFile is the profile part of the config file. Named profiles hold the same settings
+// as the top level; settings a profile leaves out are taken from the top level.
+type profileFile struct {
+	CurrentProfile string                     `json:"currentProfile,omitempty"`
+	Profiles       map[string]json.RawMessage `json:"profiles,omitempty"`
+}
+
+// SetProfile selects the profile to load, overriding JIRA_REPORT_PROFILE and "config use"
+func SetProfile(name string) {
+	profileOverride = name
+}
+
+// ActiveProfile returns the profile in use: --profile, then JIRA_REPORT_PROFILE, then the
+// profile chosen with "config use", then the default profile
+func ActiveProfile() string {
+	if profileOverride != "" {
+		return profileOverride
+	}
+	if val := os.Getenv("JIRA_REPORT_PROFILE"); val != "" {
+		return val
+	}
+	if file, err := readProfileFile(); err == nil && file.CurrentProfile != "" {
+		return file.CurrentProfile
+	}
+	return DefaultProfile
+}
+
+// ListProfiles returns the profile names, the default profile first
+func ListProfiles() ([]string, error) {
+	file, err := readProfileFile()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(file.Profiles))
+	for name := range file.Profiles {
+		if name != DefaultProfile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return append([]string{DefaultProfile}, names...), nil
+}
+
+// UseProfile makes name the profile used when neither --profile nor JIRA_REPORT_PROFILE is set
+func UseProfile(name string) error {
+	if err := checkProfileExists(name); err != nil {
+		return err
+	}
+
+	// The default profile is the top level, so clearing the setting selects it
+	var current interface{} = name
+	if name == DefaultProfile {
+		current = nil
+	}
+	return updateConfigFile(DefaultProfile, map[string]interface{}{"currentProfile": current})
+}
+
+// checkProfileName fails for names that cannot be a new profile. Names end up in file names
+// (see StateFile), so path separators are not allowed.
+func checkProfileName(name string) error {
+	if name == "" || name == DefaultProfile || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	return nil
+}
+
+// AddProfile saves a new named profile
+func AddProfile(name string, profile Config) error {
+	if err := checkProfileName(name); err != nil {
+		return err
+	}
+	if err := checkProfileExists(name); err == nil {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	return saveProfile(name, profile)
+}
+
+// TokenStore returns the OAuth token store of the active profile
+func TokenStore() *oauth.TokenStore {
+	return profileTokenStore(ActiveProfile())
+}
+
+// profileTokenStore returns the OAuth token store of a profile. The default profile keeps the
+// original keyring entry; named profiles get their own, so each can log in to a different site.
+func profileTokenStore(profile string) *oauth.TokenStore {
+	if profile == DefaultProfile {
+		return oauth.NewTokenStore()
+	}
+	return oauth.NewTokenStoreWithInstance("profile:" + profile)
+}
+
+// checkProfileExists fails unless name is the default profile or a profile in the config file
+func checkProfileExists(name string) error {
+	if name == DefaultProfile {
+		return nil
+	}
+	file, err := readProfileFile()
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Profiles[name]; !ok {
+		return fmt.Errorf("profile %q not found (see 'jira-report config list')", name)
+	}
+	return nil
+}
+
+// applyProfile overlays the settings of a named profile from the config file data on config
+func applyProfile(config *Config, data []byte, profile string) error {
+	if profile == DefaultProfile {
+		return nil
+	}
+
+	var file profileFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	settings, ok := file.Profiles[profile]
+	if !ok {
+		return fmt.Errorf("profile %q not found (see 'jira-report config list')", profile)
+	}
+
+	// Only the settings present in the profile replace the top-level ones
+	config.OAuthSite = nil
+	if err := json.Unmarshal(settings, config); err != nil {
+		return fmt.Errorf("failed to parse profile %q: %w", profile, err)
+	}
+	return nil
+}
+
+// saveProfile writes the settings of config to a profile, keeping the other profiles and keys.
+// The API tokens go to the keyring (or the secrets file).
+func saveProfile(profile string, config Config) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	// Only references to the API tokens are written to the file
+	if _, err := storeSecrets(profile, values); err != nil {
+		return err
+	}
+	return updateConfigFile(profile, values)
+}
+
+// readProfileFile reads the profile part of the config file (empty if there is no file)
+func readProfileFile() (*profileFile, error) {
+	var file profileFile
+	data, err := readConfigFile()
+	if err != nil || data == nil {
+		return &file, err
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	return &file, nil
+}
+
+// StateFile returns the path of a state file (such as the outbox or the running timer) of the
+// active profile: ~/.jira-daily-report-<name>.json, or ~/.jira-daily-report-<profile>-<name>.json
+// for named profiles, so queued writes never go to another profile's site
+func StateFile(name string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	base := strings.TrimSuffix(configFileName, ".json")
+	if profile := ActiveProfile(); profile != DefaultProfile {
+		// The profile may come from --profile or the environment, so check it stays a file name
+		if err := checkProfileName(profile); err != nil {
+			return "", err
+		}
+		base += "-" + profile
+	}
+	return filepath.Join(homeDir, base+"-"+name+".json"), nil
+}
+
+// configFilePath returns the path of the config file
+func configFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, configFileName), nil
+}
+
+// readConfigFile returns the config file contents, or nil if there is no file
+func readConfigFile() ([]byte, error) {
+	configPath, err := configFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+// useConfigFile points the home directory at a temp dir holding content as the config file,
+// with an in-memory keyring
+func useConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	keyring.MockInit()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("JIRA_REPORT_PROFILE", "")
+	SetProfile("")
+	t.Cleanup(func() { SetProfile("") })
+
+	path := filepath.Join(home, configFileName)
+	if content != "" {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+const profilesConfig = `{
+  "jiraServer": "https://one.atlassian.net",
+  "username": "me@one.com",
+  "apiToken": "one-token",
+  "tempoApiToken": "one-tempo",
+  "theme": "light",
+  "buddy": {"name": "Rex"},
+  "profiles": {
+    "two": {
+      "jiraServer": "https://two.atlassian.net",
+      "username": "me@two.com",
+      "apiToken": "two-token",
+      "tempoApiToken": "two-tempo"
+    }
+  }
+}`
+
+func TestLoadConfigAppliesProfile(t *testing.T) {
+	useConfigFile(t, profilesConfig)
+
+	cfg, err := loadConfig("two")
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.JiraServer != "https://two.atlassian.net" || cfg.ApiToken != "two-token" {
+		t.Errorf("profile settings not applied: %+v", cfg)
+	}
+	// Settings the profile leaves out come from the top level
+	if cfg.Theme != "light" {
+		t.Errorf("Theme = %q, want light", cfg.Theme)
+	}
+
+	if _, err := loadConfig("missing"); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
+
+func TestActiveProfileOrder(t *testing.T) {
+	useConfigFile(t, profilesConfig)
+
+	if got := ActiveProfile(); got != DefaultProfile {
+		t.Errorf("ActiveProfile() = %q, want default", got)
+	}
+	if err := UseProfile("two"); err != nil {
+		t.Fatalf("UseProfile: %v", err)
+	}
+	if got := ActiveProfile(); got != "two" {
+		t.Errorf("after use, ActiveProfile() = %q, want two", got)
+	}
+	t.Setenv("JIRA_REPORT_PROFILE", "env")
+	if got := ActiveProfile(); got != "env" {
+		t.Errorf("with env, ActiveProfile() = %q, want env", got)
+	}
+	SetProfile("flag")
+	if got := ActiveProfile(); got != "flag" {
+		t.Errorf("with flag, ActiveProfile() = %q, want flag", got)
+	}
+
+	if err := UseProfile("missing"); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
+
+func TestAddProfileKeepsOtherSettings(t *testing.T) {
+	path := useConfigFile(t, profilesConfig)
+
+	if err := AddProfile("three", Config{JiraServer: "https://three.atlassian.net"}); err != nil {
+		t.Fatalf("AddProfile: %v", err)
+	}
+	if err := AddProfile("two", Config{}); err == nil {
+		t.Error("expected an error for an existing profile")
+	}
+	for _, name := range []string{"", "default", "..", "a/b", `a\b`} {
+		if err := AddProfile(name, Config{}); err == nil {
+			t.Errorf("expected an error for profile name %q", name)
+		}
+	}
+
+	names, err := ListProfiles()
+	if err != nil {
+		t.Fatalf("ListProfiles: %v", err)
+	}
+	if want := []string{"default", "three", "two"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListProfiles() = %v, want %v", names, want)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["buddy"] == nil || raw["jiraServer"] != "https://one.atlassian.net" {
+		t.Errorf("top-level settings changed: %v", raw)
+	}
+}
+
+func TestStateFilePerProfile(t *testing.T) {
+	home := filepath.Dir(useConfigFile(t, profilesConfig))
+
+	path, err := StateFile("outbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(home, ".jira-daily-report-outbox.json"); path != want {
+		t.Errorf("StateFile() = %q, want %q", path, want)
+	}
+
+	SetProfile("two")
+	path, err = StateFile("outbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(home, ".jira-daily-report-two-outbox.json"); path != want {
+		t.Errorf("StateFile() = %q, want %q", path, want)
+	}
+
+	SetProfile("../two")
+	if _, err := StateFile("outbox"); err == nil {
+		t.Error("expected an error for a profile name with a path separator")
+	}
+}
@@ -0,0 +1,331 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// secretService is the keyring service holding the API tokens
+	secretService = "jira-daily-report-secrets"
+	// secretRefPrefix marks a config value that refers to a stored secret, e.g. "secret:default/apiToken"
+	secretRefPrefix = "secret:"
+	// secretsFileName is the file used when no keyring is available (e.g. headless Linux)
+	secretsFileName = ".jira-daily-report-secrets.enc"
+)
+
+// Where a secret was stored
+const (
+	SecretBackendKeyring = "keyring"
+	SecretBackendFile    = "secrets file"
+)
+
+// secretFields are the config keys holding secrets
+var secretFields = []string{"apiToken", "tempoApiToken"}
+
+// IsSecretRef reports whether a config value refers to a stored secret instead of holding it
+func IsSecretRef(value string) bool {
+	return strings.HasPrefix(value, secretRefPrefix)
+}
+
+// secretKey returns the storage key of a profile's secret
+func secretKey(profile, field string) string {
+	return profile + "/" + field
+}
+
+// StoreSecret saves a secret in the keyring, or in the secrets file if the keyring is
+// not available, and returns the backend used
+func StoreSecret(key, value string) (string, error) {
+	if err := keyring.Set(secretService, key, value); err == nil {
+		// Drop any copy saved while the keyring was unavailable, so it cannot go stale
+		_ = updateSecretsFile(func(secrets map[string]string) { delete(secrets, key) })
+		return SecretBackendKeyring, nil
+	}
+
+	err := updateSecretsFile(func(secrets map[string]string) { secrets[key] = value })
+	if err != nil {
+		return "", err
+	}
+	return SecretBackendFile, nil
+}
+
+// LoadSecret returns a secret from the keyring or the secrets file
+func LoadSecret(key string) (string, error) {
+	if value, err := keyring.Get(secretService, key); err == nil {
+		return value, nil
+	}
+
+	secrets, err := readSecretsFile()
+	if err != nil {
+		return "", err
+	}
+	value, ok := secrets[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s not found in the keyring or %s (set it again with 'jira-report config init')", key, secretsFileName)
+	}
+	return value, nil
+}
+
+// SecretsFileWarning returns a warning when secrets went to the secrets file without
+// JIRA_REPORT_SECRETS_KEY, which only obfuscates them, or "" otherwise
+func SecretsFileWarning(backend string) string {
+	if backend != SecretBackendFile || os.Getenv("JIRA_REPORT_SECRETS_KEY") != "" {
+		return ""
+	}
+	return fmt.Sprintf("⚠️  No OS keyring: the API tokens were saved in ~/%[1]s, which is only obfuscated,\n"+
+		"   not encrypted: any program running as you on this machine can read them. To encrypt them,\n"+
+		"   export JIRA_REPORT_SECRETS_KEY with a long random value (e.g. from 'openssl rand -hex 32'),\n"+
+		"   remove ~/%[1]s and save the tokens again.", secretsFileName)
+}
+
+// profileSecretsBackend returns where the API tokens of profile are stored, preferring the
+// secrets file if either token is there, or "" if neither is stored
+func profileSecretsBackend(profile string) string {
+	backend := ""
+	for _, field := range secretFields {
+		switch secretBackend(secretKey(profile, field)) {
+		case SecretBackendFile:
+			return SecretBackendFile
+		case SecretBackendKeyring:
+			backend = SecretBackendKeyring
+		}
+	}
+	return backend
+}
+
+// secretBackend returns where a secret is stored, or "" if it is missing
+func secretBackend(key string) string {
+	if _, err := keyring.Get(secretService, key); err == nil {
+		return SecretBackendKeyring
+	}
+	if secrets, err := readSecretsFile(); err == nil {
+		if _, ok := secrets[key]; ok {
+			return SecretBackendFile
+		}
+	}
+	return ""
+}
+
+// ProbeKeyring checks that the OS keyring can store, read and delete a value
+func ProbeKeyring() error {
+	const probeKey = "keyring-probe"
+	if err := keyring.Set(secretService, probeKey, "ok"); err != nil {
+		return fmt.Errorf("failed to write to keyring: %w", err)
+	}
+	defer keyring.Delete(secretService, probeKey)
+	if value, err := keyring.Get(secretService, probeKey); err != nil || value != "ok" {
+		return fmt.Errorf("failed to read back from keyring: %v", err)
+	}
+	return nil
+}
+
+// resolveSecrets replaces secret references in config with the secrets they refer to
+func resolveSecrets(config *Config) error {
+	for _, field := range []*string{&config.ApiToken, &config.TempoApiToken} {
+		if !IsSecretRef(*field) {
+			continue
+		}
+		value, err := LoadSecret(strings.TrimPrefix(*field, secretRefPrefix))
+		if err != nil {
+			return fmt.Errorf("failed to load secret: %w", err)
+		}
+		*field = value
+	}
+	return nil
+}
+
+// storeSecrets moves the secrets of values (config keys of a profile) to secret storage,
+// replacing them with references, and returns the backend used
+func storeSecrets(profile string, values map[string]interface{}) (string, error) {
+	backend := ""
+	for _, field := range secretFields {
+		value, _ := values[field].(string)
+		if value == "" || IsSecretRef(value) {
+			continue
+		}
+		key := secretKey(profile, field)
+		stored, err := StoreSecret(key, value)
+		if err != nil {
+			return "", fmt.Errorf("failed to store %s: %w", field, err)
+		}
+		backend = stored
+		values[field] = secretRefPrefix + key
+	}
+	return backend, nil
+}
+
+// MigrateSecrets moves the plaintext API tokens of every profile in the config file to secret
+// storage, leaving references in the file. It returns a line per moved secret.
+func MigrateSecrets() ([]string, error) {
+	data, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no config file found (run 'jira-report config init')")
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	profiles := map[string]map[string]interface{}{DefaultProfile: raw}
+	named, _ := raw["profiles"].(map[string]interface{})
+	for name, settings := range named {
+		if values, ok := settings.(map[string]interface{}); ok {
+			profiles[name] = values
+		}
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var moved []string
+	for _, name := range names {
+		values := map[string]interface{}{}
+		for _, field := range secretFields {
+			if value, _ := profiles[name][field].(string); value != "" && !IsSecretRef(value) {
+				values[field] = value
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		backend, err := storeSecrets(name, values)
+		if err != nil {
+			return moved, err
+		}
+		// The references are only written once the secrets are safely stored
+		if err := updateConfigFile(name, values); err != nil {
+			return moved, err
+		}
+		for _, field := range secretFields {
+			if _, ok := values[field]; ok {
+				moved = append(moved, fmt.Sprintf("%s: %s (%s)", name, field, backend))
+			}
+		}
+	}
+	return moved, nil
+}
+
+// secretsFilePath returns the path of the secrets file
+func secretsFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, secretsFileName), nil
+}
+
+// secretsFileKey derives the encryption key of the secrets file from JIRA_REPORT_SECRETS_KEY, or
+// else from the machine ID, hostname and user. Those are readable by any process of the user, so
+// without JIRA_REPORT_SECRETS_KEY the file is only obfuscated: a copied file is useless elsewhere,
+// but anything running as the user on this machine can decrypt it.
+func secretsFileKey() []byte {
+	material := os.Getenv("JIRA_REPORT_SECRETS_KEY")
+	if material == "" {
+		machineID, _ := os.ReadFile("/etc/machine-id")
+		hostname, _ := os.Hostname()
+		user := os.Getenv("USER")
+		if user == "" {
+			user = os.Getenv("USERNAME") // Windows
+		}
+		material = strings.TrimSpace(string(machineID)) + ":" + hostname + ":" + user
+	}
+	key := sha256.Sum256([]byte("jira-daily-report-secrets:" + material))
+	return key[:]
+}
+
+// readSecretsFile decrypts the secrets file (empty if there is no file)
+func readSecretsFile() (map[string]string, error) {
+	path, err := secretsFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	gcm, err := secretsCipher()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("secrets file %s is corrupt", path)
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s (was it created on another machine or with a different JIRA_REPORT_SECRETS_KEY?)", path)
+	}
+
+	secrets := map[string]string{}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to parse secrets file: %w", err)
+	}
+	return secrets, nil
+}
+
+// updateSecretsFile applies change to the secrets in the file and writes it back encrypted
+func updateSecretsFile(change func(secrets map[string]string)) error {
+	secrets, err := readSecretsFile()
+	if err != nil {
+		return err
+	}
+	change(secrets)
+
+	path, err := secretsFilePath()
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove secrets file: %w", err)
+		}
+		return nil
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("failed to marshal secrets: %w", err)
+	}
+	gcm, err := secretsCipher()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	if err := os.WriteFile(path, gcm.Seal(nonce, nonce, plaintext, nil), 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	return nil
+}
+
+// secretsCipher returns the AES-GCM cipher of the secrets file
+func secretsCipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(secretsFileKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func TestMigrateSecretsMovesTokensToKeyring(t *testing.T) {
+	path := useConfigFile(t, profilesConfig)
+
+	moved, err := MigrateSecrets()
+	if err != nil {
+		t.Fatalf("MigrateSecrets: %v", err)
+	}
+	if len(moved) != 4 {
+		t.Errorf("moved %v, want the two tokens of both profiles", moved)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "one-token") || strings.Contains(string(data), "two-tempo") {
+		t.Errorf("plaintext tokens left in config file:\n%s", data)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["apiToken"] != "secret:default/apiToken" || raw["buddy"] == nil {
+		t.Errorf("unexpected config file after migration: %v", raw)
+	}
+
+	cfg, err := loadConfig("two")
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if cfg.ApiToken != "two-token" || cfg.TempoApiToken != "two-tempo" {
+		t.Errorf("secrets not resolved: %q, %q", cfg.ApiToken, cfg.TempoApiToken)
+	}
+
+	// Running it again finds nothing left to move
+	if moved, err := MigrateSecrets(); err != nil || len(moved) != 0 {
+		t.Errorf("second MigrateSecrets() = %v, %v", moved, err)
+	}
+}
+
+func TestSecretsFallBackToEncryptedFile(t *testing.T) {
+	path := useConfigFile(t, "")
+	keyring.MockInitWithError(errors.New("no keyring"))
+	t.Setenv("JIRA_REPORT_SECRETS_KEY", "passphrase")
+
+	backend, err := StoreSecret("default/apiToken", "hunter2")
+	if err != nil {
+		t.Fatalf("StoreSecret: %v", err)
+	}
+	if backend != SecretBackendFile {
+		t.Errorf("backend = %q, want the secrets file", backend)
+	}
+
+	data, err := os.ReadFile(strings.TrimSuffix(path, configFileName) + secretsFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Error("secrets file is not encrypted")
+	}
+
+	value, err := LoadSecret("default/apiToken")
+	if err != nil || value != "hunter2" {
+		t.Errorf("LoadSecret() = %q, %v", value, err)
+	}
+
+	t.Setenv("JIRA_REPORT_SECRETS_KEY", "wrong")
+	if _, err := LoadSecret("default/apiToken"); err == nil {
+		t.Error("expected an error with the wrong key")
+	}
+}
+
+func TestSecretsFileWarning(t *testing.T) {
+	t.Setenv("JIRA_REPORT_SECRETS_KEY", "")
+	if SecretsFileWarning(SecretBackendKeyring) != "" {
+		t.Error("expected no warning for the keyring")
+	}
+	if !strings.Contains(SecretsFileWarning(SecretBackendFile), "only obfuscated") {
+		t.Error("expected a warning for the secrets file without JIRA_REPORT_SECRETS_KEY")
+	}
+
+	t.Setenv("JIRA_REPORT_SECRETS_KEY", "passphrase")
+	if SecretsFileWarning(SecretBackendFile) != "" {
+		t.Error("expected no warning with JIRA_REPORT_SECRETS_KEY set")
+	}
+}
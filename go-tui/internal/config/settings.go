@@ -0,0 +1,472 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// Check is the outcome of one configuration check
+type Check struct {
+	Name   string
+	Detail string // What was found, shown when the check passes
+	Err    error  // Why the check failed, or nil
+	Hint   string // How to fix a failed check
+}
+
+// Passed reports whether the check succeeded
+func (c Check) Passed() bool {
+	return c.Err == nil
+}
+
+// fileOnlyKeys are config file keys that are not settings of a profile
+var fileOnlyKeys = map[string]bool{"profiles": true, "currentProfile": true, "buddy": true}
+
+// SetValue sets a setting of the active profile in the config file, e.g. "theme" or
+// "timerRounding.minutes". List settings take comma-separated values, an empty value removes
+// the setting, and API tokens are stored as secrets.
+func SetValue(key, value string) error {
+	settingType, err := lookupSetting(key)
+	if err != nil {
+		return err
+	}
+	if key == "oauthSite" || strings.HasPrefix(key, "oauthSite.") {
+		return fmt.Errorf("oauthSite is managed by 'jira-report auth sites select'")
+	}
+
+	profile := ActiveProfile()
+	if err := checkProfileExists(profile); err != nil {
+		return err
+	}
+
+	var parsed interface{}
+	if value != "" {
+		if parsed, err = parseSetting(settingType, value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+	}
+
+	// API tokens go to the keyring (or the secrets file) and the file refers to them
+	if parsed != nil && isSecretField(key) {
+		ref := secretKey(profile, key)
+		if _, err := StoreSecret(ref, value); err != nil {
+			return fmt.Errorf("failed to store %s: %w", key, err)
+		}
+		parsed = secretRefPrefix + ref
+	}
+
+	values, err := profileValues(profile)
+	if err != nil {
+		return err
+	}
+
+	// Nested settings are written back as their whole parent object
+	topKey, newValue := key, parsed
+	if parent, child, nested := strings.Cut(key, "."); nested {
+		object, _ := values[parent].(map[string]interface{})
+		updated := map[string]interface{}{}
+		for k, v := range object {
+			updated[k] = v
+		}
+		if parsed == nil {
+			delete(updated, child)
+		} else {
+			updated[child] = parsed
+		}
+		topKey, newValue = parent, updated
+		if len(updated) == 0 {
+			newValue = nil
+		}
+	}
+
+	if err := checkSettings(values, topKey, newValue); err != nil {
+		return err
+	}
+	return updateConfigFile(profile, map[string]interface{}{topKey: newValue})
+}
+
+// GetValue returns the effective value of a setting of the active profile, including
+// environment overrides. API tokens are masked; unset settings are empty.
+func GetValue(key string) (string, error) {
+	if _, ok := resolveSetting(key); !ok {
+		return "", fmt.Errorf("unknown setting %q (see 'jira-report config get --help')", key)
+	}
+	config, err := readConfig(ActiveProfile())
+	if err != nil {
+		return "", err
+	}
+
+	var values map[string]interface{}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	var value interface{} = values
+	for _, part := range strings.Split(key, ".") {
+		object, _ := value.(map[string]interface{})
+		value = object[part]
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		if isSecretField(key) && v != "" {
+			return maskToken(v), nil
+		}
+		return v, nil
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ","), nil
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s: %w", key, err)
+	}
+	return string(out), nil
+}
+
+// SettingKeys returns the keys accepted by SetValue and GetValue
+func SettingKeys() []string {
+	var keys []string
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		name := jsonName(field)
+		if field.Type.Kind() == reflect.Struct {
+			for j := 0; j < field.Type.NumField(); j++ {
+				keys = append(keys, name+"."+jsonName(field.Type.Field(j)))
+			}
+			continue
+		}
+		if field.Type.Kind() != reflect.Ptr {
+			keys = append(keys, name)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateSchema checks the configuration of the active profile without contacting Jira
+func ValidateSchema() []Check {
+	profile := ActiveProfile()
+	checks := []Check{}
+
+	fileCheck := Check{Name: "Config file"}
+	path, _ := configFilePath()
+	data, err := readConfigFile()
+	switch {
+	case err != nil:
+		fileCheck.Err = err
+	case data == nil:
+		fileCheck.Detail = "not found, using environment variables"
+	default:
+		var raw map[string]interface{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			fileCheck.Err = fmt.Errorf("failed to parse %s: %w", path, err)
+		} else {
+			fileCheck.Detail = path
+		}
+	}
+	fileCheck.Hint = "Fix the JSON syntax, or recreate the file with 'jira-report config init'"
+	checks = append(checks, fileCheck)
+	if !fileCheck.Passed() {
+		return checks
+	}
+
+	config, err := readConfig(profile)
+	checks = append(checks, Check{
+		Name:   "Profile",
+		Detail: profile,
+		Err:    err,
+		Hint:   "Check the profile with 'jira-report config list' and the JIRA_* environment variables; set a missing token again with 'jira-report config set apiToken <token>'",
+	})
+	if err != nil {
+		return checks
+	}
+
+	if unknown := unknownSettings(data, profile); len(unknown) > 0 {
+		checks = append(checks, Check{
+			Name: "Setting names",
+			Err:  fmt.Errorf("unknown settings: %s", strings.Join(unknown, ", ")),
+			Hint: "Check for typos; 'jira-report config get --help' lists the known settings",
+		})
+	} else {
+		checks = append(checks, Check{Name: "Setting names", Detail: "all known"})
+	}
+
+	requiredCheck := Check{Name: "Required settings", Detail: "present"}
+	if err := validateConfig(config, profile); err != nil {
+		requiredCheck.Err = err
+		requiredCheck.Hint = "Set it with 'jira-report config set <key> <value>' or log in with 'jira-report auth login'"
+	}
+	checks = append(checks, requiredCheck)
+
+	serverCheck := Check{Name: "Jira server URL", Detail: config.JiraServer}
+	if parsed, err := url.Parse(config.JiraServer); err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		serverCheck.Err = fmt.Errorf("%q is not an http(s) URL", config.JiraServer)
+		serverCheck.Hint = "Use the full site URL, e.g. 'jira-report config set jiraServer https://your-domain.atlassian.net'"
+	}
+	checks = append(checks, serverCheck)
+
+	return checks
+}
+
+// SettingSource describes where the effective value of a setting comes from
+type SettingSource struct {
+	Key    string
+	Value  string // API tokens are masked
+	Source string // e.g. "environment (JIRA_SERVER)", "profile work" or "config file"
+}
+
+// sourceKeys are the settings reported by SettingSources
+var sourceKeys = []string{"deployment", "jiraServer", "username", "apiToken", "tempoApiToken", "whoAmI", "theme"}
+
+// SettingSources reports the effective value of the main settings of the active profile and
+// whether each comes from the environment, the profile or the top level of the config file
+func SettingSources() ([]SettingSource, error) {
+	profile := ActiveProfile()
+	profileSettings, err := profileValues(profile)
+	if err != nil {
+		return nil, err
+	}
+	topSettings, err := profileValues(DefaultProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []SettingSource
+	for _, key := range sourceKeys {
+		value, err := GetValue(key)
+		if err != nil {
+			return nil, err
+		}
+		source := SettingSource{Key: key, Value: value, Source: "not set"}
+
+		fileValue, inProfile := profileSettings[key]
+		if !inProfile {
+			fileValue = topSettings[key]
+		}
+		switch {
+		case inProfile && profile != DefaultProfile:
+			source.Source = "profile " + profile
+		case fileValue != nil:
+			source.Source = "config file"
+		}
+		if ref, _ := fileValue.(string); IsSecretRef(ref) {
+			backend := secretBackend(strings.TrimPrefix(ref, secretRefPrefix))
+			if backend == "" {
+				backend = "missing secret"
+			}
+			source.Source += ", " + backend
+		}
+
+		// Environment variables win over the file
+		for _, override := range envOverrides {
+			if override.key == key && os.Getenv(override.env) != "" {
+				source.Source = fmt.Sprintf("environment (%s)", override.env)
+			}
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// lookupSetting returns the type of the setting at key, e.g. "theme" or "timesheet.requiredHours"
+func lookupSetting(key string) (reflect.Type, error) {
+	settingType, ok := resolveSetting(key)
+	if !ok {
+		return nil, fmt.Errorf("unknown setting %q (see 'jira-report config get --help')", key)
+	}
+	if settingType.Kind() == reflect.Struct {
+		return nil, fmt.Errorf("%s has several settings; use %s.<name> instead", key, key)
+	}
+	return settingType, nil
+}
+
+// resolveSetting returns the type of the Config field at key, following at most one level of nesting
+func resolveSetting(key string) (reflect.Type, bool) {
+	parts := strings.Split(key, ".")
+	if len(parts) > 2 {
+		return nil, false
+	}
+	current := reflect.TypeOf(Config{})
+	for _, part := range parts {
+		if current.Kind() == reflect.Ptr {
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field, ok := fieldByJSONName(current, part)
+		if !ok {
+			return nil, false
+		}
+		current = field.Type
+	}
+	return current, true
+}
+
+// parseSetting converts a command-line value to the JSON value of a setting type
+func parseSetting(settingType reflect.Type, value string) (interface{}, error) {
+	switch settingType.Kind() {
+	case reflect.String:
+		return value, nil
+	case reflect.Bool:
+		return strconv.ParseBool(value)
+	case reflect.Int:
+		return strconv.Atoi(value)
+	case reflect.Float64:
+		return strconv.ParseFloat(value, 64)
+	case reflect.Slice:
+		return splitList(value), nil
+	}
+	return nil, fmt.Errorf("unsupported setting type %s", settingType)
+}
+
+// setSetting parses value and sets the setting at key in config
+func setSetting(config *Config, key, value string) error {
+	field := reflect.ValueOf(config).Elem()
+	for _, part := range strings.Split(key, ".") {
+		structField, ok := fieldByJSONName(field.Type(), part)
+		if !ok {
+			return fmt.Errorf("unknown setting %q", key)
+		}
+		field = field.FieldByIndex(structField.Index)
+	}
+
+	parsed, err := parseSetting(field.Type(), value)
+	if err != nil {
+		return err
+	}
+	field.Set(reflect.ValueOf(parsed).Convert(field.Type()))
+	return nil
+}
+
+// checkSettings checks that a profile's settings are still valid with key set to value
+func checkSettings(values map[string]interface{}, key string, value interface{}) error {
+	candidate := map[string]interface{}{}
+	for k, v := range values {
+		candidate[k] = v
+	}
+	candidate[key] = value
+
+	data, err := json.Marshal(candidate)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	if _, err := model.ParseDeployment(config.Deployment); err != nil {
+		return fmt.Errorf("invalid deployment: %w", err)
+	}
+	if err := config.TimerRounding.Validate(); err != nil {
+		return fmt.Errorf("invalid timerRounding: %w", err)
+	}
+	return nil
+}
+
+// profileValues returns the settings saved in a profile, without the top-level ones it inherits
+func profileValues(profile string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	data, err := readConfigFile()
+	if err != nil || data == nil {
+		return values, err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if profile == DefaultProfile {
+		return raw, nil
+	}
+	profiles, _ := raw["profiles"].(map[string]interface{})
+	if settings, ok := profiles[profile].(map[string]interface{}); ok {
+		return settings, nil
+	}
+	return values, nil
+}
+
+// unknownSettings returns the keys of the top level and the profile that are not settings
+func unknownSettings(data []byte, profile string) []string {
+	var unknown []string
+	collect := func(prefix string, values map[string]interface{}) {
+		for key, value := range values {
+			if prefix == "" && fileOnlyKeys[key] {
+				continue
+			}
+			settingType, ok := resolveSetting(key)
+			if !ok {
+				unknown = append(unknown, prefix+key)
+				continue
+			}
+			// Objects such as timerRounding are checked key by key
+			if object, isObject := value.(map[string]interface{}); isObject && settingType.Kind() == reflect.Struct {
+				for child := range object {
+					if _, ok := resolveSetting(key + "." + child); !ok {
+						unknown = append(unknown, prefix+key+"."+child)
+					}
+				}
+			}
+		}
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	collect("", raw)
+	if profile != DefaultProfile {
+		profiles, _ := raw["profiles"].(map[string]interface{})
+		settings, _ := profiles[profile].(map[string]interface{})
+		collect("profiles."+profile+".", settings)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// isSecretField reports whether key holds an API token
+func isSecretField(key string) bool {
+	for _, field := range secretFields {
+		if key == field {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByJSONName finds the struct field encoded as name
+func fieldByJSONName(structType reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		if jsonName(structType.Field(i)) == name {
+			return structType.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// jsonName returns the JSON key of a struct field
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestSetValueWritesActiveProfile(t *testing.T) {
+	path := useConfigFile(t, profilesConfig)
+	SetProfile("two")
+
+	if err := SetValue("timerRounding.minutes", "30"); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+	if err := SetValue("blockedStatuses", "Blocked, On Hold"); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+	if err := SetValue("apiToken", "new-token"); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw struct {
+		Theme    string
+		Profiles map[string]map[string]interface{}
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	two := raw.Profiles["two"]
+	if two["apiToken"] != "secret:two/apiToken" {
+		t.Errorf("apiToken = %v, want a secret reference", two["apiToken"])
+	}
+	if rounding, _ := two["timerRounding"].(map[string]interface{}); rounding["minutes"] != float64(30) {
+		t.Errorf("timerRounding = %v", two["timerRounding"])
+	}
+	if raw.Theme != "light" {
+		t.Errorf("top-level theme changed to %q", raw.Theme)
+	}
+
+	for key, want := range map[string]string{
+		"apiToken":              "new-****oken",
+		"blockedStatuses":       "Blocked,On Hold",
+		"timerRounding.minutes": "30",
+		"theme":                 "light",
+		"deployment":            "",
+	} {
+		got, err := GetValue(key)
+		if err != nil {
+			t.Errorf("GetValue(%q): %v", key, err)
+		} else if got != want {
+			t.Errorf("GetValue(%q) = %q, want %q", key, got, want)
+		}
+	}
+
+	// An empty value removes the setting, so the top-level one applies again
+	if err := SetValue("apiToken", ""); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+	if got, _ := GetValue("apiToken"); got != "one-****oken" {
+		t.Errorf("after unset, GetValue(apiToken) = %q", got)
+	}
+}
+
+func TestSetValueRejectsInvalidSettings(t *testing.T) {
+	useConfigFile(t, profilesConfig)
+
+	for key, value := range map[string]string{
+		"noSuchKey":          "1",
+		"timerRounding":      "15",
+		"timerRounding.mode": "sideways",
+		"autoClipboard":      "maybe",
+		"deployment":         "mainframe",
+		"oauthSite.cloudId":  "abc",
+	} {
+		if err := SetValue(key, value); err == nil {
+			t.Errorf("SetValue(%q, %q) succeeded", key, value)
+		}
+	}
+}
+
+func TestValidateSchemaReportsUnknownSettings(t *testing.T) {
+	useConfigFile(t, `{"jiraServer": "https://one.atlassian.net", "username": "me", "apiToken": "t",
+		"tempoApiToken": "t", "theem": "dark", "timerRounding": {"minutes": 15, "mod": "up"}}`)
+
+	failed := map[string]bool{}
+	for _, check := range ValidateSchema() {
+		if !check.Passed() {
+			failed[check.Name] = true
+		}
+	}
+	if len(failed) != 1 || !failed["Setting names"] {
+		t.Errorf("failed checks = %v, want only the setting names", failed)
+	}
+}
+
+func TestSettingSourcesReportPrecedence(t *testing.T) {
+	useConfigFile(t, profilesConfig)
+	SetProfile("two")
+	t.Setenv("JIRA_USERNAME", "env-user")
+	if err := SetValue("tempoApiToken", "secret-tempo"); err != nil {
+		t.Fatalf("SetValue: %v", err)
+	}
+
+	sources, err := SettingSources()
+	if err != nil {
+		t.Fatalf("SettingSources: %v", err)
+	}
+	got := map[string]SettingSource{}
+	for _, source := range sources {
+		got[source.Key] = source
+	}
+
+	for key, want := range map[string]string{
+		"jiraServer":    "profile two",
+		"username":      "environment (JIRA_USERNAME)",
+		"tempoApiToken": "profile two, keyring",
+		"theme":         "config file",
+		"whoAmI":        "not set",
+	} {
+		if got[key].Source != want {
+			t.Errorf("source of %s = %q, want %q", key, got[key].Source, want)
+		}
+	}
+	if got["tempoApiToken"].Value != "secr****empo" {
+		t.Errorf("tempoApiToken not masked: %q", got["tempoApiToken"].Value)
+	}
+}
@@ -1,7 +1,10 @@
 package dateutil
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -61,3 +64,53 @@ func previousWeekday(now time.Time, weekday time.Weekday) time.Time {
 func normalizeDateInput(s string) string {
 	return strings.Join(strings.Fields(strings.ToLower(strings.TrimSpace(s))), " ")
 }
+
+// WeekStart returns the Monday of the week containing t, at midnight
+func WeekStart(t time.Time) time.Time {
+	daysBack := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysBack, 0, 0, 0, 0, t.Location())
+}
+
+// ParseStartTime normalizes a worklog start time like "9:00", "09:00" or "09:00:00" to HH:MM:SS
+func ParseStartTime(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format("15:04:05"), nil
+		}
+	}
+	return "", fmt.Errorf("invalid start time %q (expected HH:MM)", s)
+}
+
+var durationPattern = regexp.MustCompile(`^(?:(\d+(?:\.\d+)?)h)?(?:(\d+(?:\.\d+)?)m)?$`)
+
+// ParseDuration parses a worklog duration like "2h", "1.5h", "30m", "1h 30m" or "1h 30" into
+// seconds. A plain number is hours. Zero is allowed (e.g. for non-billable time).
+func ParseDuration(s string) (int, error) {
+	input := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+	if input == "" {
+		return 0, errors.New("empty duration")
+	}
+	if hours, err := strconv.ParseFloat(input, 64); err == nil && durationPattern.MatchString(input+"h") {
+		return int(hours * 3600), nil
+	}
+	// Minutes may follow hours without a unit, as in "1h 30"
+	if strings.Contains(input, "h") && !strings.HasSuffix(input, "h") && !strings.HasSuffix(input, "m") {
+		input += "m"
+	}
+
+	match := durationPattern.FindStringSubmatch(input)
+	if match == nil {
+		return 0, fmt.Errorf("%q is not a duration (e.g. 2h, 30m, 1h30m)", s)
+	}
+	var seconds float64
+	if match[1] != "" {
+		hours, _ := strconv.ParseFloat(match[1], 64)
+		seconds += hours * 3600
+	}
+	if match[2] != "" {
+		minutes, _ := strconv.ParseFloat(match[2], 64)
+		seconds += minutes * 60
+	}
+	return int(seconds), nil
+}
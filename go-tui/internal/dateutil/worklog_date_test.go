@@ -46,3 +46,68 @@ func TestParseWorklogDateAtInvalid(t *testing.T) {
 		t.Fatal("parseWorklogDateAt() error = nil, want error")
 	}
 }
+
+func TestWeekStart(t *testing.T) {
+	tests := []struct {
+		name string
+		in   time.Time
+		want string
+	}{
+		{name: "wednesday", in: time.Date(2026, 4, 29, 15, 0, 0, 0, time.UTC), want: "2026-04-27"},
+		{name: "monday", in: time.Date(2026, 4, 27, 9, 0, 0, 0, time.UTC), want: "2026-04-27"},
+		{name: "sunday", in: time.Date(2026, 5, 3, 9, 0, 0, 0, time.UTC), want: "2026-04-27"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeekStart(tt.in).Format("2006-01-02"); got != tt.want {
+				t.Fatalf("WeekStart() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStartTime(t *testing.T) {
+	for in, want := range map[string]string{"9:00": "09:00:00", "13:45": "13:45:00", "08:15:30": "08:15:30"} {
+		got, err := ParseStartTime(in)
+		if err != nil || got != want {
+			t.Errorf("ParseStartTime(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"25:00", "9am", ""} {
+		if _, err := ParseStartTime(in); err == nil {
+			t.Errorf("ParseStartTime(%q) error = nil, want error", in)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{input: "2h", want: 7200},
+		{input: "1h 30m", want: 5400},
+		{input: "1h 30", want: 5400},
+		{input: "1.5h", want: 5400},
+		{input: "45m", want: 2700},
+		{input: "1.5", want: 5400},
+		{input: "0", want: 0},
+		{input: "2x", wantErr: true},
+		{input: "1e3", wantErr: true},
+		{input: "-1", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
@@ -9,15 +9,14 @@ import (
 	"net/http"
 
 	"github.com/yourusername/jira-daily-report/internal/config"
+	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
 // Transition represents a Jira status transition
 type Transition struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	To   struct {
-		Name string `json:"name"`
-	} `json:"to"`
+	ID   string       `json:"id"`
+	Name string       `json:"name"`
+	To   model.Status `json:"to"` // Target status, including its category
 }
 
 // TransitionsResponse represents the API response
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deployment is the kind of Jira installation: Atlassian Cloud or a self-hosted Server/Data Center
+type Deployment string
+
+// Supported deployment types
+const (
+	DeploymentCloud  Deployment = "cloud"
+	DeploymentServer Deployment = "server" // Jira Server and Data Center
+)
+
+// ParseDeployment parses a deployment type; empty means Cloud and "datacenter"/"dc" mean Server
+func ParseDeployment(value string) (Deployment, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "cloud":
+		return DeploymentCloud, nil
+	case "server", "datacenter", "data-center", "dc":
+		return DeploymentServer, nil
+	}
+	return "", fmt.Errorf("unknown deployment type %q (expected cloud or server)", value)
+}
@@ -0,0 +1,15 @@
+package model
+
+import "testing"
+
+func TestParseDeployment(t *testing.T) {
+	for value, want := range map[string]Deployment{"": DeploymentCloud, "Cloud": DeploymentCloud, "server": DeploymentServer, "datacenter": DeploymentServer, "DC": DeploymentServer} {
+		got, err := ParseDeployment(value)
+		if err != nil || got != want {
+			t.Errorf("ParseDeployment(%q) = %q, %v; want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseDeployment("on-prem"); err == nil {
+		t.Error("expected an unknown deployment type to fail")
+	}
+}
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusGroup is the bucket a workflow status is sorted into
+type StatusGroup string
+
+const (
+	StatusGroupInProgress StatusGroup = "inProgress"
+	StatusGroupTodo       StatusGroup = "todo"
+	StatusGroupReview     StatusGroup = "review"
+	StatusGroupTesting    StatusGroup = "testing"
+)
+
+// Jira status category keys, as returned in status.statusCategory.key
+const (
+	StatusCategoryNew        = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
+// JQL names of the "indeterminate" and "new" status categories
+const (
+	statusCategoryInProgressJQL = "In Progress"
+	statusCategoryTodoJQL       = "To Do"
+)
+
+// StatusMapping maps workflow status names to groups. Names are matched case-insensitively;
+// statuses not listed fall back to their Jira status category (see Group).
+type StatusMapping struct {
+	InProgress []string `json:"inProgress,omitempty"`
+	Todo       []string `json:"todo,omitempty"`
+	Review     []string `json:"review,omitempty"`
+	Testing    []string `json:"testing,omitempty"`
+}
+
+// DefaultStatusMapping returns the mapping for the standard Jira Software workflow
+func DefaultStatusMapping() StatusMapping {
+	return StatusMapping{
+		InProgress: []string{"In Progress"},
+		Todo:       []string{"Open", "Selected for Development"},
+		Review:     []string{"Under Review", "Code Review", "Review"},
+		Testing:    []string{"Ready for Testing", "QA", "Testing", "To Test"},
+	}
+}
+
+// WithDefaults returns a copy with every empty group filled from DefaultStatusMapping
+func (m StatusMapping) WithDefaults() StatusMapping {
+	defaults := DefaultStatusMapping()
+	if len(m.InProgress) == 0 {
+		m.InProgress = defaults.InProgress
+	}
+	if len(m.Todo) == 0 {
+		m.Todo = defaults.Todo
+	}
+	if len(m.Review) == 0 {
+		m.Review = defaults.Review
+	}
+	if len(m.Testing) == 0 {
+		m.Testing = defaults.Testing
+	}
+	return m
+}
+
+// Statuses returns the status names of a group
+func (m StatusMapping) Statuses(group StatusGroup) []string {
+	switch group {
+	case StatusGroupInProgress:
+		return m.InProgress
+	case StatusGroupTodo:
+		return m.Todo
+	case StatusGroupReview:
+		return m.Review
+	case StatusGroupTesting:
+		return m.Testing
+	}
+	return nil
+}
+
+// Group returns the group for a status. Mapped names win; otherwise Jira's status category
+// decides ("indeterminate" is in progress, "new" is todo). Done statuses have no group.
+func (m StatusMapping) Group(status Status) (StatusGroup, bool) {
+	for _, group := range []StatusGroup{StatusGroupInProgress, StatusGroupTodo, StatusGroupReview, StatusGroupTesting} {
+		for _, name := range m.Statuses(group) {
+			if strings.EqualFold(name, status.Name) {
+				return group, true
+			}
+		}
+	}
+
+	if status.StatusCategory != nil {
+		switch status.StatusCategory.Key {
+		case StatusCategoryInProgress:
+			return StatusGroupInProgress, true
+		case StatusCategoryNew:
+			return StatusGroupTodo, true
+		}
+	}
+	return "", false
+}
+
+// QuoteJQL quotes a value, such as a status name, for use in JQL
+func QuoteJQL(value string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "\\'"))
+}
+
+// JQL returns a JQL condition matching the given groups, e.g. status IN ('Open', 'QA').
+// Like Group, unmapped statuses in the "In Progress" and "To Do" categories are matched
+// too for the in-progress and todo groups, so custom workflow statuses are not lost.
+func (m StatusMapping) JQL(groups ...StatusGroup) string {
+	var quoted, categories []string
+	for _, group := range groups {
+		for _, name := range m.Statuses(group) {
+			quoted = append(quoted, QuoteJQL(name))
+		}
+		switch group {
+		case StatusGroupInProgress:
+			categories = append(categories, statusCategoryInProgressJQL)
+		case StatusGroupTodo:
+			categories = append(categories, statusCategoryTodoJQL)
+		}
+	}
+
+	var conditions []string
+	if len(quoted) > 0 {
+		conditions = append(conditions, fmt.Sprintf("status IN (%s)", strings.Join(quoted, ", ")))
+	}
+	for _, category := range categories {
+		conditions = append(conditions, fmt.Sprintf("statusCategory = %s", QuoteJQL(category)))
+	}
+	if len(conditions) == 0 {
+		return "status IS EMPTY"
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")"
+}
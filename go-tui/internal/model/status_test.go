@@ -0,0 +1,51 @@
+package model
+
+import "testing"
+
+func TestStatusMappingGroup(t *testing.T) {
+	mapping := StatusMapping{
+		InProgress: []string{"Doing"},
+		Review:     []string{"Peer Review"},
+		Testing:    []string{"UAT"},
+	}.WithDefaults()
+
+	tests := []struct {
+		name   string
+		status Status
+		want   StatusGroup
+		wantOK bool
+	}{
+		{name: "custom in progress", status: Status{Name: "doing"}, want: StatusGroupInProgress, wantOK: true},
+		{name: "custom review wins over category", status: Status{Name: "Peer Review", StatusCategory: &StatusCategory{Key: StatusCategoryInProgress}}, want: StatusGroupReview, wantOK: true},
+		{name: "custom testing", status: Status{Name: "UAT"}, want: StatusGroupTesting, wantOK: true},
+		{name: "default todo kept", status: Status{Name: "Open"}, want: StatusGroupTodo, wantOK: true},
+		{name: "unmapped in progress category", status: Status{Name: "Pairing", StatusCategory: &StatusCategory{Key: StatusCategoryInProgress}}, want: StatusGroupInProgress, wantOK: true},
+		{name: "unmapped new category", status: Status{Name: "Backlog", StatusCategory: &StatusCategory{Key: StatusCategoryNew}}, want: StatusGroupTodo, wantOK: true},
+		{name: "done category", status: Status{Name: "Closed", StatusCategory: &StatusCategory{Key: StatusCategoryDone}}, wantOK: false},
+		{name: "unknown without category", status: Status{Name: "Mystery"}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := mapping.Group(tt.status)
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("Group(%+v) = (%q, %v), want (%q, %v)", tt.status, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestStatusMappingJQL(t *testing.T) {
+	mapping := StatusMapping{InProgress: []string{"Doing"}, Todo: []string{"Ready", "Won't Do"}}
+
+	if got, want := mapping.JQL(StatusGroupTodo), `(status IN ('Ready', 'Won\'t Do') OR statusCategory = 'To Do')`; got != want {
+		t.Errorf("JQL(todo) = %q, want %q", got, want)
+	}
+	if got, want := mapping.JQL(StatusGroupInProgress), `(status IN ('Doing') OR statusCategory = 'In Progress')`; got != want {
+		t.Errorf("JQL(inProgress) = %q, want %q", got, want)
+	}
+	// Unmapped To Do statuses such as "Selected for Development" must be fetched to reach Group
+	if got, want := mapping.JQL(StatusGroupInProgress, StatusGroupTodo), `(status IN ('Doing', 'Ready', 'Won\'t Do') OR statusCategory = 'In Progress' OR statusCategory = 'To Do')`; got != want {
+		t.Errorf("JQL(inProgress, todo) = %q, want %q", got, want)
+	}
+}
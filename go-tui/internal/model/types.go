@@ -1,5 +1,17 @@
 package model
 
+import (
+	"encoding/json"
+	"time"
+)
+
+// DefaultFlaggedField is the usual Jira Cloud ID of the "Flagged" (Impediment) custom field. Sites
+// differ, so the ID is configured or looked up by name and this is only the last resort.
+const DefaultFlaggedField = "customfield_10021"
+
+// FlaggedFieldName is the name of the custom field that holds the Flagged marker
+const FlaggedFieldName = "Flagged"
+
 // Issue represents a Jira issue
 type Issue struct {
 	ID     string      `json:"id"`
@@ -17,6 +29,8 @@ type IssueFields struct {
 	Description interface{}  `json:"description,omitempty"`
 	FixVersions []FixVersion `json:"fixVersions,omitempty"`
 	Updated     string       `json:"updated,omitempty"`
+	IssueLinks  []IssueLink  `json:"issuelinks,omitempty"`
+	Flagged     []FlagOption `json:"flagged,omitempty"` // Filled by the client from the site's Flagged field
 }
 
 // IssueParent represents the minimal parent issue data needed for hierarchy display.
@@ -34,12 +48,51 @@ type IssueParentFields struct {
 
 // Status represents the issue status
 type Status struct {
-	Name string `json:"name"`
+	Name           string          `json:"name"`
+	StatusCategory *StatusCategory `json:"statusCategory,omitempty"`
+}
+
+// StatusCategory represents the status category (key is "new", "indeterminate" or "done")
+type StatusCategory struct {
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
+}
+
+// IssueLink represents a link between two issues; only one of InwardIssue/OutwardIssue is set
+type IssueLink struct {
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *LinkedIssue  `json:"inwardIssue,omitempty"`
+	OutwardIssue *LinkedIssue  `json:"outwardIssue,omitempty"`
+}
+
+// IssueLinkType describes a link type, e.g. Name "Blocks", Inward "is blocked by", Outward "blocks"
+type IssueLinkType struct {
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// LinkedIssue is the minimal issue data embedded in an issue link
+type LinkedIssue struct {
+	Key    string            `json:"key"`
+	Fields LinkedIssueFields `json:"fields"`
+}
+
+// LinkedIssueFields contains the linked issue fields
+type LinkedIssueFields struct {
+	Summary string `json:"summary"`
+	Status  Status `json:"status"`
+}
+
+// FlagOption is a value of the Flagged custom field, e.g. "Impediment"
+type FlagOption struct {
+	Value string `json:"value"`
 }
 
 // IssueType represents the issue type
 type IssueType struct {
-	Name string `json:"name"`
+	Name    string `json:"name"`
+	Subtask bool   `json:"subtask,omitempty"`
 }
 
 // Priority represents the issue priority
@@ -54,12 +107,45 @@ type FixVersion struct {
 
 // Worklog represents a Tempo worklog entry
 type Worklog struct {
-	TempoWorklogID   int          `json:"tempoWorklogId"`
-	Issue            WorklogIssue `json:"issue"`
-	TimeSpentSeconds int          `json:"timeSpentSeconds"`
-	StartDate        string       `json:"startDate"`
-	Description      string       `json:"description,omitempty"`
-	Author           Author       `json:"author"`
+	TempoWorklogID   int                  `json:"tempoWorklogId"`
+	Issue            WorklogIssue         `json:"issue"`
+	TimeSpentSeconds int                  `json:"timeSpentSeconds"`
+	StartDate        string               `json:"startDate"`
+	StartTime        string               `json:"startTime,omitempty"`
+	Description      string               `json:"description,omitempty"`
+	Author           Author               `json:"author"`
+	BillableSeconds  *int                 `json:"billableSeconds,omitempty"`
+	Attributes       []WorkAttributeValue `json:"attributes,omitempty"`
+	CreatedAt        time.Time            `json:"createdAt,omitempty"` // When Tempo stored the worklog
+}
+
+// UnmarshalJSON decodes a worklog, accepting attributes both as a list and in the
+// {"values": [...]} wrapper returned by Tempo
+func (w *Worklog) UnmarshalJSON(data []byte) error {
+	type plain Worklog
+	var decoded struct {
+		plain
+		Attributes json.RawMessage `json:"attributes,omitempty"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*w = Worklog(decoded.plain)
+
+	if len(decoded.Attributes) == 0 || string(decoded.Attributes) == "null" {
+		return nil
+	}
+	if decoded.Attributes[0] == '[' {
+		return json.Unmarshal(decoded.Attributes, &w.Attributes)
+	}
+	var wrapped struct {
+		Values []WorkAttributeValue `json:"values"`
+	}
+	if err := json.Unmarshal(decoded.Attributes, &wrapped); err != nil {
+		return err
+	}
+	w.Attributes = wrapped.Values
+	return nil
 }
 
 // WorklogIssue represents the issue reference in a worklog
@@ -67,16 +153,20 @@ type WorklogIssue struct {
 	ID      int    `json:"id"` // Tempo API returns this as a number
 	Key     string `json:"key"`
 	Summary string `json:"summary"`
+	// The epic is filled in during enrichment (not returned by Tempo): the issue's parent, or
+	// the parent's parent for sub-tasks
+	EpicKey     string `json:"epicKey,omitempty"`
+	EpicSummary string `json:"epicSummary,omitempty"`
 }
 
 // Author represents the worklog author
 type Author struct {
-	AccountID string `json:"accountId"`
+	AccountID string `json:"accountId"` // Username (or user key) on Server/Data Center
 }
 
 // User represents a Jira user
 type User struct {
-	AccountID    string `json:"accountId"`
+	AccountID    string `json:"accountId"` // Username on Server/Data Center
 	DisplayName  string `json:"displayName"`
 	EmailAddress string `json:"emailAddress"`
 }
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Tempo work attribute types
+const (
+	WorkAttributeStaticList   = "STATIC_LIST"
+	WorkAttributeAccount      = "ACCOUNT"
+	WorkAttributeCheckbox     = "CHECKBOX"
+	WorkAttributeInputField   = "INPUT_FIELD"
+	WorkAttributeInputNumeric = "INPUT_NUMERIC"
+)
+
+// WorkAttribute is a work attribute configured in Tempo, such as "Work Type"
+type WorkAttribute struct {
+	Key      string            `json:"key"`
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Required bool              `json:"required"`
+	Values   []string          `json:"values,omitempty"` // Allowed values of a STATIC_LIST
+	Names    map[string]string `json:"names,omitempty"`  // Display names of the values
+}
+
+// ValueName returns the display name of a value, falling back to the value itself
+func (a WorkAttribute) ValueName(value string) string {
+	if name, ok := a.Names[value]; ok && name != "" {
+		return name
+	}
+	return value
+}
+
+// Options returns the values to pick from: the list values, or true/false for a checkbox
+func (a WorkAttribute) Options() []string {
+	switch a.Type {
+	case WorkAttributeStaticList:
+		return a.Values
+	case WorkAttributeCheckbox:
+		return []string{"true", "false"}
+	}
+	return nil
+}
+
+// WorkAttributeValue is the value of a Tempo work attribute on a worklog
+type WorkAttributeValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ParseWorkAttributeValues parses "key=value" pairs
+func ParseWorkAttributeValues(pairs []string) ([]WorkAttributeValue, error) {
+	var values []WorkAttributeValue
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid work attribute %q (expected key=value)", pair)
+		}
+		values = append(values, WorkAttributeValue{Key: key, Value: value})
+	}
+	return values, nil
+}
+
+// ValidateWorkAttributes checks values against the configured attributes: keys must exist
+// (matched by key or name), list values must be allowed and required attributes must be set.
+// Keys given by name are replaced with the attribute key.
+func ValidateWorkAttributes(defs []WorkAttribute, values []WorkAttributeValue) ([]WorkAttributeValue, error) {
+	byKey := make(map[string]WorkAttribute, len(defs))
+	for _, def := range defs {
+		byKey[strings.ToLower(def.Key)] = def
+		byKey[strings.ToLower(def.Name)] = def
+	}
+
+	set := make(map[string]bool, len(values))
+	validated := make([]WorkAttributeValue, 0, len(values))
+	for _, value := range values {
+		def, ok := byKey[strings.ToLower(value.Key)]
+		if !ok {
+			return nil, fmt.Errorf("unknown work attribute %q", value.Key)
+		}
+		if err := def.validateValue(value.Value); err != nil {
+			return nil, err
+		}
+		set[def.Key] = true
+		validated = append(validated, WorkAttributeValue{Key: def.Key, Value: value.Value})
+	}
+
+	var missing []string
+	for _, def := range defs {
+		if def.Required && !set[def.Key] {
+			missing = append(missing, def.Name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required work attributes: %s", strings.Join(missing, ", "))
+	}
+
+	return validated, nil
+}
+
+// validateValue checks a value against the attribute type
+func (a WorkAttribute) validateValue(value string) error {
+	switch a.Type {
+	case WorkAttributeStaticList, WorkAttributeCheckbox:
+		for _, option := range a.Options() {
+			if option == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q for %s (allowed: %s)", value, a.Name, strings.Join(a.Options(), ", "))
+	case WorkAttributeInputNumeric:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s must be a number, got %q", a.Name, value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package model
+
+import "testing"
+
+func TestValidateWorkAttributes(t *testing.T) {
+	defs := []WorkAttribute{
+		{Key: "_WorkType_", Name: "Work Type", Type: WorkAttributeStaticList, Required: true, Values: []string{"Dev", "Meeting"}},
+		{Key: "_Hours_", Name: "Hours", Type: WorkAttributeInputNumeric},
+	}
+
+	tests := []struct {
+		name    string
+		values  []WorkAttributeValue
+		wantKey string
+		wantErr bool
+	}{
+		{name: "by key", values: []WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}}, wantKey: "_WorkType_"},
+		{name: "by name", values: []WorkAttributeValue{{Key: "work type", Value: "Meeting"}}, wantKey: "_WorkType_"},
+		{name: "value not in list", values: []WorkAttributeValue{{Key: "_WorkType_", Value: "Lunch"}}, wantErr: true},
+		{name: "required missing", values: []WorkAttributeValue{{Key: "_Hours_", Value: "2"}}, wantErr: true},
+		{name: "not a number", values: []WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}, {Key: "_Hours_", Value: "two"}}, wantErr: true},
+		{name: "unknown key", values: []WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}, {Key: "_Team_", Value: "A"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateWorkAttributes(defs, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateWorkAttributes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got[0].Key != tt.wantKey {
+				t.Errorf("key = %q, want %q", got[0].Key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestParseWorkAttributeValues(t *testing.T) {
+	values, err := ParseWorkAttributeValues([]string{"_WorkType_=Dev", " Account = ACC-1 "})
+	if err != nil {
+		t.Fatalf("ParseWorkAttributeValues() error = %v", err)
+	}
+	if len(values) != 2 || values[1].Key != "Account" || values[1].Value != "ACC-1" {
+		t.Errorf("unexpected values: %+v", values)
+	}
+
+	if _, err := ParseWorkAttributeValues([]string{"_WorkType_"}); err == nil {
+		t.Error("expected an error for a pair without a value")
+	}
+}
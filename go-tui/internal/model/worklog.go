@@ -1,12 +1,75 @@
 package model
 
+import "sort"
+
 // WorklogRequest represents a request to create a worklog
 type WorklogRequest struct {
-	IssueID          int    `json:"issueId"`
-	TimeSpentSeconds int    `json:"timeSpentSeconds"`
-	StartDate        string `json:"startDate"` // YYYY-MM-DD
-	Description      string `json:"description,omitempty"`
-	AuthorAccountID  string `json:"authorAccountId"`
+	IssueID          int                  `json:"issueId"`
+	TimeSpentSeconds int                  `json:"timeSpentSeconds"`
+	StartDate        string               `json:"startDate"`           // YYYY-MM-DD
+	StartTime        string               `json:"startTime,omitempty"` // HH:MM:SS
+	Description      string               `json:"description,omitempty"`
+	AuthorAccountID  string               `json:"authorAccountId"`
+	BillableSeconds  *int                 `json:"billableSeconds,omitempty"` // nil bills the time spent
+	Attributes       []WorkAttributeValue `json:"attributes,omitempty"`
+}
+
+// NewWorklogCopy returns a request that recreates worklog on startDate with seconds logged,
+// keeping its start time, description, billable time and work attributes. Billable time that
+// matched the time spent follows the new time.
+func NewWorklogCopy(worklog Worklog, seconds int, startDate, authorAccountID string) WorklogRequest {
+	billable := rebaseBillable(worklog.BillableSeconds, worklog.TimeSpentSeconds, seconds)
+	return WorklogRequest{
+		IssueID:          worklog.Issue.ID,
+		TimeSpentSeconds: seconds,
+		StartDate:        startDate,
+		StartTime:        worklog.StartTime,
+		Description:      worklog.Description,
+		AuthorAccountID:  authorAccountID,
+		BillableSeconds:  billable,
+		Attributes:       worklog.Attributes,
+	}
+}
+
+// rebaseBillable returns the billable time for a worklog whose time spent changes from
+// oldSeconds to newSeconds: billable time that matched the time spent follows it, and it
+// never exceeds the new time
+func rebaseBillable(billable *int, oldSeconds, newSeconds int) *int {
+	if billable == nil || (*billable != oldSeconds && *billable <= newSeconds) {
+		return billable
+	}
+	return &newSeconds
+}
+
+// WorklogUpdate represents a request to update an existing worklog (Tempo replaces all fields)
+type WorklogUpdate struct {
+	TimeSpentSeconds int                  `json:"timeSpentSeconds"`
+	StartDate        string               `json:"startDate"`           // YYYY-MM-DD
+	StartTime        string               `json:"startTime,omitempty"` // HH:MM:SS
+	Description      string               `json:"description,omitempty"`
+	AuthorAccountID  string               `json:"authorAccountId"`
+	BillableSeconds  *int                 `json:"billableSeconds,omitempty"` // nil bills the time spent
+	Attributes       []WorkAttributeValue `json:"attributes,omitempty"`
+}
+
+// NewWorklogUpdate returns an update that keeps every field of worklog, to be changed as needed.
+// Use SetTimeSpent to change the time, so the billable time follows.
+func NewWorklogUpdate(worklog Worklog) WorklogUpdate {
+	return WorklogUpdate{
+		TimeSpentSeconds: worklog.TimeSpentSeconds,
+		StartDate:        worklog.StartDate,
+		StartTime:        worklog.StartTime,
+		Description:      worklog.Description,
+		AuthorAccountID:  worklog.Author.AccountID,
+		BillableSeconds:  worklog.BillableSeconds,
+		Attributes:       worklog.Attributes,
+	}
+}
+
+// SetTimeSpent changes the time spent, with billable time that matched it following the new time
+func (u *WorklogUpdate) SetTimeSpent(seconds int) {
+	u.BillableSeconds = rebaseBillable(u.BillableSeconds, u.TimeSpentSeconds, seconds)
+	u.TimeSpentSeconds = seconds
 }
 
 // WorklogResponseIssue represents the issue in a worklog creation response
@@ -24,3 +87,49 @@ type WorklogResponse struct {
 	StartDate      string               `json:"startDate"`
 	Description    string               `json:"description,omitempty"`
 }
+
+// Tempo user schedule day types
+const (
+	ScheduleWorkingDay    = "WORKING_DAY"
+	ScheduleNonWorkingDay = "NON_WORKING_DAY"
+	ScheduleHoliday       = "HOLIDAY"
+)
+
+// ScheduleDay is one day of a user's work schedule and the time they are expected to log
+type ScheduleDay struct {
+	Date            string `json:"date"` // YYYY-MM-DD
+	RequiredSeconds int    `json:"requiredSeconds"`
+	Type            string `json:"type"`
+}
+
+// GroupWorklogsByDate groups worklogs by start date, newest first
+func GroupWorklogsByDate(worklogs []Worklog) []DateGroup {
+	groupMap := make(map[string]*DateGroup)
+
+	for _, log := range worklogs {
+		if _, exists := groupMap[log.StartDate]; !exists {
+			groupMap[log.StartDate] = &DateGroup{
+				Date:         log.StartDate,
+				DisplayDate:  log.StartDate, // TODO: Format properly
+				Worklogs:     []Worklog{},
+				TotalSeconds: 0,
+			}
+		}
+		group := groupMap[log.StartDate]
+		group.Worklogs = append(group.Worklogs, log)
+		group.TotalSeconds += log.TimeSpentSeconds
+	}
+
+	// Convert to slice
+	var groups []DateGroup
+	for _, group := range groupMap {
+		groups = append(groups, *group)
+	}
+
+	// Sort by date descending (newest first)
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Date > groups[j].Date
+	})
+
+	return groups
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewWorklogCopyKeepsAllFields(t *testing.T) {
+	billable := 3600
+	source := Worklog{
+		Issue:            WorklogIssue{ID: 1001, Key: "GRAP-1"},
+		TimeSpentSeconds: 3600,
+		StartDate:        "2026-04-01",
+		StartTime:        "09:30:00",
+		Description:      "Standup",
+		BillableSeconds:  &billable,
+		Attributes:       []WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}},
+	}
+
+	got := NewWorklogCopy(source, 1800, "2026-04-02", "acc")
+	if got.IssueID != 1001 || got.StartDate != "2026-04-02" || got.StartTime != "09:30:00" || got.Description != "Standup" || got.AuthorAccountID != "acc" {
+		t.Errorf("NewWorklogCopy() = %+v", got)
+	}
+	if !reflect.DeepEqual(got.Attributes, source.Attributes) {
+		t.Errorf("Attributes = %v, want %v", got.Attributes, source.Attributes)
+	}
+	// Fully billable time stays fully billable at the new duration
+	if got.BillableSeconds == nil || *got.BillableSeconds != 1800 {
+		t.Errorf("BillableSeconds = %v, want 1800", got.BillableSeconds)
+	}
+
+	partial := 600
+	source.BillableSeconds = &partial
+	if got := NewWorklogCopy(source, 1800, "2026-04-02", "acc"); got.BillableSeconds == nil || *got.BillableSeconds != 600 {
+		t.Errorf("BillableSeconds = %v, want 600", got.BillableSeconds)
+	}
+}
+
+func TestWorklogUpdateSetTimeSpentRebasesBillable(t *testing.T) {
+	tests := []struct {
+		name     string
+		billable *int
+		seconds  int
+		want     *int
+	}{
+		{name: "fully billable grows", billable: intPtr(3600), seconds: 7200, want: intPtr(7200)},
+		{name: "fully billable shrinks", billable: intPtr(3600), seconds: 1800, want: intPtr(1800)},
+		{name: "partly billable grows", billable: intPtr(1200), seconds: 7200, want: intPtr(1200)},
+		{name: "partly billable shrinks below it", billable: intPtr(3000), seconds: 1800, want: intPtr(1800)},
+		{name: "non-billable", billable: intPtr(0), seconds: 7200, want: intPtr(0)},
+		{name: "unset", billable: nil, seconds: 7200, want: nil},
+	}
+
+	for _, tt := range tests {
+		update := NewWorklogUpdate(Worklog{TimeSpentSeconds: 3600, StartDate: "2026-04-01", BillableSeconds: tt.billable})
+		update.SetTimeSpent(tt.seconds)
+		if update.TimeSpentSeconds != tt.seconds || !reflect.DeepEqual(update.BillableSeconds, tt.want) {
+			t.Errorf("%s: SetTimeSpent(%d) = %d seconds, billable %v, want billable %v", tt.name, tt.seconds, update.TimeSpentSeconds, deref(update.BillableSeconds), deref(tt.want))
+		}
+	}
+}
+
+func intPtr(v int) *int { return &v }
+
+// deref formats an optional number for messages
+func deref(v *int) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -152,12 +152,12 @@ func (c *AtlassianClient) FindSiteByURL(ctx context.Context, siteURL string) (*A
 	}
 
 	for _, site := range sites {
-		if site.URL == siteURL {
+		if SameSiteURL(site.URL, siteURL) {
 			return &site, nil
 		}
 	}
 
-	return nil, fmt.Errorf("site not found: %s", siteURL)
+	return nil, fmt.Errorf("site %s is not accessible with this login (accessible: %s); run 'jira-report auth sites select' to choose one", siteURL, siteURLs(sites))
 }
 
 // GetCloudID returns the cloud ID for a given site URL
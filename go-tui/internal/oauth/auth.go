@@ -28,6 +28,13 @@ func NewAuthenticator(config *Config) *Authenticator {
 	}
 }
 
+// NewAuthenticatorWithStore creates an authenticator that keeps its token in store
+func NewAuthenticatorWithStore(config *Config, store *TokenStore) *Authenticator {
+	authenticator := NewAuthenticator(config)
+	authenticator.store = store
+	return authenticator
+}
+
 // Authenticate performs OAuth authentication
 // First tries to use existing valid token, otherwise starts new auth flow
 func (a *Authenticator) Authenticate(ctx context.Context) (*oauth2.Token, error) {
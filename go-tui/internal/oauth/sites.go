@@ -0,0 +1,47 @@
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SameSiteURL reports whether two site URLs point at the same site, ignoring case and trailing slashes
+func SameSiteURL(a, b string) bool {
+	return normalizeSiteURL(a) == normalizeSiteURL(b)
+}
+
+// normalizeSiteURL trims whitespace and trailing slashes and lowercases the URL
+func normalizeSiteURL(siteURL string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(siteURL), "/"))
+}
+
+// siteURLs lists the site URLs for error messages
+func siteURLs(sites []AtlassianSite) string {
+	if len(sites) == 0 {
+		return "none"
+	}
+	urls := make([]string, len(sites))
+	for i, site := range sites {
+		urls[i] = site.URL
+	}
+	return strings.Join(urls, ", ")
+}
+
+// MatchSite returns the site whose URL, name or cloud ID matches query. Names are compared
+// case-insensitively; it fails rather than guess when no site or several sites match.
+func MatchSite(sites []AtlassianSite, query string) (*AtlassianSite, error) {
+	var matches []AtlassianSite
+	for _, site := range sites {
+		if SameSiteURL(site.URL, query) || site.ID == query || strings.EqualFold(site.Name, strings.TrimSpace(query)) {
+			matches = append(matches, site)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no accessible site matches %q (accessible: %s)", query, siteURLs(sites))
+	case 1:
+		return &matches[0], nil
+	}
+	return nil, fmt.Errorf("%q matches several sites (%s); use the site URL instead", query, siteURLs(matches))
+}
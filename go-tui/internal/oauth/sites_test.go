@@ -0,0 +1,34 @@
+package oauth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSite(t *testing.T) {
+	sites := []AtlassianSite{
+		{ID: "111", Name: "Acme", URL: "https://acme.atlassian.net"},
+		{ID: "222", Name: "Acme", URL: "https://acme-labs.atlassian.net"},
+		{ID: "333", Name: "Globex", URL: "https://globex.atlassian.net"},
+	}
+
+	site, err := MatchSite(sites, "https://ACME.atlassian.net/")
+	require.NoError(t, err)
+	assert.Equal(t, "111", site.ID)
+
+	site, err = MatchSite(sites, "globex")
+	require.NoError(t, err)
+	assert.Equal(t, "333", site.ID)
+
+	site, err = MatchSite(sites, "222")
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme-labs.atlassian.net", site.URL)
+
+	// Ambiguous or unknown sites are errors rather than a guess
+	_, err = MatchSite(sites, "Acme")
+	assert.Error(t, err)
+	_, err = MatchSite(sites, "https://initech.atlassian.net")
+	assert.Error(t, err)
+}
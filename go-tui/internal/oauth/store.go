@@ -140,7 +140,7 @@ func (s *TokenStore) GetValidToken(ctx context.Context, config *Config) (*oauth2
 	}
 
 	// Check if token is about to expire (within 5 minutes)
-	if time.Until(token.Expiry) < 5*time.Minute {
+	if needsRefresh(token) {
 		if token.RefreshToken == "" {
 			return nil, fmt.Errorf("token expired and no refresh token available")
 		}
@@ -161,8 +161,11 @@ func (s *TokenStore) refreshToken(ctx context.Context, config *Config, token *oa
 	const maxRetries = 3
 	var lastErr error
 
+	// Only pass the refresh token: the oauth2 package would return a not-yet-expired access token as is
+	expiring := &oauth2.Token{RefreshToken: token.RefreshToken}
+
 	for i := 0; i < maxRetries; i++ {
-		newToken, err := config.GetBaseConfig().TokenSource(ctx, token).Token()
+		newToken, err := config.GetBaseConfig().TokenSource(ctx, expiring).Token()
 		if err == nil {
 			if saveErr := s.SaveToken(newToken); saveErr != nil {
 				return nil, fmt.Errorf("failed to save refreshed token: %w", saveErr)
@@ -178,6 +181,51 @@ func (s *TokenStore) refreshToken(ctx context.Context, config *Config, token *oa
 	return nil, fmt.Errorf("failed to refresh token after %d attempts: %w", maxRetries, lastErr)
 }
 
+// TokenInfo describes a stored token without exposing it
+type TokenInfo struct {
+	Backend       string // "keyring" or "keyring (fallback entry)"
+	Expiry        time.Time
+	CreatedAt     time.Time
+	HasRefresh    bool
+	DeviceHash    string // Device the token was created on
+	DeviceMatches bool   // Whether that is this device
+}
+
+// Inspect describes the stored token for diagnostics. Unlike LoadToken it does not validate the
+// token or delete one bound to another device; it returns nil if no token is stored.
+func (s *TokenStore) Inspect() (*TokenInfo, error) {
+	backend := "keyring"
+	tokenData, err := keyring.Get(serviceName, s.getUserKey())
+	if err != nil {
+		backend = "keyring (fallback entry)"
+		if tokenData, err = keyring.Get(serviceName, s.getUserKey()+"_file"); err != nil {
+			return nil, nil
+		}
+	}
+
+	var secureToken SecureToken
+	if err := json.Unmarshal([]byte(tokenData), &secureToken); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	if secureToken.Token == nil {
+		return nil, fmt.Errorf("stored token is empty")
+	}
+
+	return &TokenInfo{
+		Backend:       backend,
+		Expiry:        secureToken.Expiry,
+		CreatedAt:     secureToken.CreatedAt,
+		HasRefresh:    secureToken.RefreshToken != "",
+		DeviceHash:    secureToken.DeviceHash,
+		DeviceMatches: secureToken.DeviceHash == s.deviceHash,
+	}, nil
+}
+
+// DeviceHash returns the hash tokens of this device are bound to
+func (s *TokenStore) DeviceHash() string {
+	return s.deviceHash
+}
+
 // HasToken returns true if a token exists in storage
 func (s *TokenStore) HasToken() bool {
 	_, err := s.LoadToken()
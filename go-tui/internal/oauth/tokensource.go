@@ -0,0 +1,93 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// refreshWindow is how long before expiry a token is refreshed
+const refreshWindow = 5 * time.Minute
+
+// needsRefresh reports whether token expires within refreshWindow (tokens without an expiry never do)
+func needsRefresh(token *oauth2.Token) bool {
+	return !token.Expiry.IsZero() && time.Until(token.Expiry) < refreshWindow
+}
+
+// storeTokenSource serves the stored token and refreshes it through the store shortly before it
+// expires, so the refreshed token (and any rotated refresh token) is persisted for later runs
+type storeTokenSource struct {
+	ctx    context.Context
+	store  *TokenStore
+	config *Config
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// TokenSource returns an oauth2.TokenSource backed by the store. Tokens are refreshed with config
+// shortly before they expire and saved back to the store; ctx is used for the refresh requests.
+func (s *TokenStore) TokenSource(ctx context.Context, config *Config) oauth2.TokenSource {
+	return &storeTokenSource{ctx: ctx, store: s, config: config}
+}
+
+// Token implements oauth2.TokenSource
+func (s *storeTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && !needsRefresh(s.token) {
+		return s.token, nil
+	}
+
+	if s.config == nil {
+		// Without app credentials the stored token is usable until it expires
+		token, err := s.store.LoadToken()
+		if err != nil {
+			return nil, err
+		}
+		if !token.Valid() {
+			return nil, fmt.Errorf("OAuth token expired and no app credentials are available to refresh it; run 'jira-report auth login'")
+		}
+		s.token = token
+		return token, nil
+	}
+
+	// GetValidToken reloads the store first, so a token refreshed by another process is reused
+	token, err := s.store.GetValidToken(s.ctx, s.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh OAuth token: %w", err)
+	}
+	s.token = token
+	return token, nil
+}
+
+// LoadConfig builds the OAuth configuration used for refreshing tokens. Environment variables
+// override the credentials saved by "auth init", which override the embedded defaults.
+func LoadConfig() (*Config, error) {
+	clientID, clientSecret, callbackURL := "", "", ""
+	if creds, err := LoadAppCredentials(); err == nil {
+		clientID, clientSecret, callbackURL = creds.ClientID, creds.ClientSecret, creds.CallbackURL
+	} else if HasDefaultCredentials() {
+		clientID, clientSecret, callbackURL = GetDefaultCredentials()
+	}
+
+	if val := os.Getenv("JIRA_OAUTH_CLIENT_ID"); val != "" {
+		clientID = val
+	}
+	if val := os.Getenv("JIRA_OAUTH_CLIENT_SECRET"); val != "" {
+		clientSecret = val
+	}
+	if val := os.Getenv("JIRA_OAUTH_CALLBACK_URL"); val != "" {
+		callbackURL = val
+	}
+
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("OAuth app credentials not found (run 'jira-report auth init')")
+	}
+	return NewConfig(clientID, clientSecret, callbackURL), nil
+}
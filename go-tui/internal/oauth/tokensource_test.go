@@ -0,0 +1,85 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+	"golang.org/x/oauth2"
+)
+
+func TestTokenSourceRefreshesAndPersists(t *testing.T) {
+	keyring.MockInit()
+
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+		refreshes++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"fresh-%d","refresh_token":"rotated","token_type":"Bearer","expires_in":3600}`, refreshes)
+	}))
+	defer server.Close()
+
+	store := NewTokenStoreWithInstance("https://example.atlassian.net")
+	// Still valid for a minute, but inside the refresh window
+	require.NoError(t, store.SaveToken(&oauth2.Token{AccessToken: "stale", RefreshToken: "original", Expiry: time.Now().Add(time.Minute)}))
+
+	config := &Config{oauth2: &oauth2.Config{ClientID: "id", ClientSecret: "secret", Endpoint: oauth2.Endpoint{TokenURL: server.URL}}}
+	source := store.TokenSource(context.Background(), config)
+
+	token, err := source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "fresh-1", token.AccessToken)
+
+	// The refreshed token is reused, not refreshed again
+	token, err = source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "fresh-1", token.AccessToken)
+	assert.Equal(t, 1, refreshes)
+
+	saved, err := store.LoadToken()
+	require.NoError(t, err)
+	assert.Equal(t, "fresh-1", saved.AccessToken)
+	assert.Equal(t, "rotated", saved.RefreshToken)
+}
+
+func TestTokenSourceWithoutConfigRejectsExpiredToken(t *testing.T) {
+	keyring.MockInit()
+
+	store := NewTokenStoreWithInstance("https://example.atlassian.net")
+	require.NoError(t, store.SaveToken(&oauth2.Token{AccessToken: "old", RefreshToken: "r", Expiry: time.Now().Add(-time.Hour)}))
+
+	_, err := store.TokenSource(context.Background(), nil).Token()
+	assert.Error(t, err)
+}
+
+func TestInspectKeepsTokenFromOtherDevice(t *testing.T) {
+	keyring.MockInit()
+
+	store := NewTokenStoreWithInstance("https://example.atlassian.net")
+	info, err := store.Inspect()
+	require.NoError(t, err)
+	assert.Nil(t, info)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, store.SaveToken(&oauth2.Token{AccessToken: "a", RefreshToken: "r", Expiry: expiry}))
+
+	other := NewTokenStoreWithInstance("https://example.atlassian.net")
+	other.deviceHash = "another-device"
+	info, err = other.Inspect()
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.False(t, info.DeviceMatches)
+	assert.True(t, info.HasRefresh)
+	assert.True(t, info.Expiry.Equal(expiry))
+
+	// The token is still there for the device it belongs to
+	assert.True(t, store.HasToken())
+}
@@ -0,0 +1,296 @@
+// Package outbox queues Jira and Tempo writes that failed for lack of connectivity
+// and replays them once the network is back.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// Kinds of queued writes
+const (
+	KindWorklog    = "worklog"
+	KindTransition = "transition"
+)
+
+// Transition is a queued issue status change
+type Transition struct {
+	TransitionID string `json:"transitionId"`
+	TargetStatus string `json:"targetStatus"`
+}
+
+// Entry is one queued write with its payload
+type Entry struct {
+	ID         string                `json:"id"`
+	Kind       string                `json:"kind"`
+	IssueKey   string                `json:"issueKey"`
+	CreatedAt  time.Time             `json:"createdAt"` // When the write was first attempted
+	Attempts   int                   `json:"attempts"`
+	LastError  string                `json:"lastError,omitempty"`
+	Ambiguous  bool                  `json:"ambiguous,omitempty"` // The failed request may have reached the server
+	Worklog    *model.WorklogRequest `json:"worklog,omitempty"`
+	Transition *Transition           `json:"transition,omitempty"`
+}
+
+// NewWorklogEntry creates an entry that logs time to an issue
+func NewWorklogEntry(issueKey string, request model.WorklogRequest) Entry {
+	return Entry{Kind: KindWorklog, IssueKey: issueKey, Worklog: &request}
+}
+
+// NewTransitionEntry creates an entry that changes an issue's status
+func NewTransitionEntry(issueKey, transitionID, targetStatus string) Entry {
+	return Entry{
+		Kind:       KindTransition,
+		IssueKey:   issueKey,
+		Transition: &Transition{TransitionID: transitionID, TargetStatus: targetStatus},
+	}
+}
+
+// Summary describes the entry in one line, e.g. "Log 1h30m to GRAP-1 on 2026-04-01"
+func (e Entry) Summary() string {
+	switch {
+	case e.Kind == KindWorklog && e.Worklog != nil:
+		return fmt.Sprintf("Log %s to %s on %s", formatSeconds(e.Worklog.TimeSpentSeconds), e.IssueKey, e.Worklog.StartDate)
+	case e.Kind == KindTransition && e.Transition != nil:
+		return fmt.Sprintf("Move %s to '%s'", e.IssueKey, e.Transition.TargetStatus)
+	}
+	return fmt.Sprintf("%s %s", e.Kind, e.IssueKey)
+}
+
+// formatSeconds formats a duration as "1h30m"
+func formatSeconds(seconds int) string {
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// IsOffline reports whether err is a network failure (no connection, DNS, timeout)
+// rather than an error returned by the server or a cancelled request
+func IsOffline(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// IsAmbiguous reports whether a request that failed with the offline err may still have
+// reached the server: a connection that broke or timed out after the request was sent,
+// rather than a failed DNS lookup or connect
+func IsAmbiguous(err error) bool {
+	if !IsOffline(err) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return false
+	}
+	var opErr *net.OpError
+	return !errors.As(err, &opErr) || opErr.Op != "dial"
+}
+
+// SetError records the error a write failed with
+func (e *Entry) SetError(err error) {
+	e.LastError = err.Error()
+	e.Ambiguous = e.Ambiguous || IsAmbiguous(err)
+}
+
+// Store persists the outbox to disk so queued writes survive restarts. The file is shared
+// by the CLI and the TUI, so every change is made under an OS file lock.
+type Store struct {
+	path string
+	mu   sync.Mutex // Serializes goroutines; the file lock serializes processes
+}
+
+// NewStore creates a store at ~/.jira-daily-report-outbox.json
+func NewStore() (*Store, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return NewStoreAt(filepath.Join(homeDir, ".jira-daily-report-outbox.json")), nil
+}
+
+// NewStoreAt creates a store backed by the given file
+func NewStoreAt(path string) *Store {
+	return &Store{path: path}
+}
+
+// List returns the queued entries, oldest first
+func (s *Store) List() ([]Entry, error) {
+	var list []Entry
+	err := s.locked(func(entries []Entry) ([]Entry, error) {
+		list = entries
+		return nil, errUnchanged
+	})
+	return list, err
+}
+
+// Add queues an entry, assigning its ID and, unless set, its creation time
+func (s *Store) Add(entry Entry) (Entry, error) {
+	err := s.locked(func(entries []Entry) ([]Entry, error) {
+		now := time.Now()
+		if entry.CreatedAt.IsZero() {
+			entry.CreatedAt = now
+		}
+		entry.ID = strconv.FormatInt(now.UnixNano(), 36)
+		return append(entries, entry), nil
+	})
+	return entry, err
+}
+
+// get returns the queued entry with the given ID, reporting whether it is still queued
+func (s *Store) get(id string) (Entry, bool, error) {
+	var found Entry
+	queued := false
+	err := s.locked(func(entries []Entry) ([]Entry, error) {
+		for _, entry := range entries {
+			if entry.ID == id {
+				found, queued = entry, true
+			}
+		}
+		return nil, errUnchanged
+	})
+	return found, queued, err
+}
+
+// Update replaces the stored entry with the same ID
+func (s *Store) Update(entry Entry) error {
+	return s.locked(func(entries []Entry) ([]Entry, error) {
+		for i := range entries {
+			if entries[i].ID == entry.ID {
+				entries[i] = entry
+				return entries, nil
+			}
+		}
+		return nil, fmt.Errorf("outbox entry %s not found", entry.ID)
+	})
+}
+
+// Remove drops an entry from the queue, reporting whether it existed
+func (s *Store) Remove(id string) (bool, error) {
+	removed := false
+	err := s.locked(func(entries []Entry) ([]Entry, error) {
+		for i := range entries {
+			if entries[i].ID == id {
+				removed = true
+				return append(entries[:i], entries[i+1:]...), nil
+			}
+		}
+		return nil, errUnchanged
+	})
+	return removed, err
+}
+
+// Clear drops every entry and returns how many were queued
+func (s *Store) Clear() (int, error) {
+	count := 0
+	err := s.locked(func(entries []Entry) ([]Entry, error) {
+		count = len(entries)
+		return nil, nil
+	})
+	return count, err
+}
+
+// errUnchanged tells locked to leave the file as it is
+var errUnchanged = errors.New("outbox unchanged")
+
+// locked loads the queue under the store's locks, passes it to change and saves what change
+// returns, unless change returns an error (errUnchanged to only read)
+func (s *Store) locked(change func(entries []Entry) ([]Entry, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlock, err := lockFile(s.path+".lock", true)
+	if err != nil {
+		return fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	defer unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+	entries, err = change(entries)
+	if errors.Is(err, errUnchanged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.save(entries)
+}
+
+// lockFlush takes the lock held while the outbox is replayed, so two processes never replay
+// the same entries. It reports false if another replay holds it.
+func (s *Store) lockFlush() (unlock func(), ok bool, err error) {
+	unlock, err = lockFile(s.path+".flush.lock", false)
+	if errors.Is(err, errLocked) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	return unlock, true, nil
+}
+
+// errLocked is returned by lockFile when it does not wait and another process holds the lock
+var errLocked = errors.New("locked by another process")
+
+// load reads the queue; callers hold the lock
+func (s *Store) load() ([]Entry, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse outbox: %w", err)
+	}
+	return entries, nil
+}
+
+// save writes the queue, removing the file once it is empty; callers hold the lock
+func (s *Store) save(entries []Entry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to write outbox: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox: %w", err)
+	}
+	// Write to a temporary file first so a crash never leaves a truncated queue
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestStoreQueuesEntries(t *testing.T) {
+	store := NewStoreAt(filepath.Join(t.TempDir(), "outbox.json"))
+
+	first, err := store.Add(NewWorklogEntry("GRAP-1", model.WorklogRequest{IssueID: 1, TimeSpentSeconds: 5400, StartDate: "2026-04-01"}))
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if _, err := store.Add(NewTransitionEntry("GRAP-2", "31", "Done")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	entries, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != first.ID || entries[0].ID == entries[1].ID {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	if got := entries[0].Summary(); got != "Log 1h30m to GRAP-1 on 2026-04-01" {
+		t.Errorf("Summary() = %q", got)
+	}
+	if got := entries[1].Summary(); got != "Move GRAP-2 to 'Done'" {
+		t.Errorf("Summary() = %q", got)
+	}
+
+	removed, err := store.Remove(first.ID)
+	if err != nil || !removed {
+		t.Fatalf("Remove() = %v, %v", removed, err)
+	}
+	count, err := store.Clear()
+	if err != nil || count != 1 {
+		t.Fatalf("Clear() = %d, %v", count, err)
+	}
+	if entries, _ := store.List(); len(entries) != 0 {
+		t.Errorf("expected an empty outbox, got %+v", entries)
+	}
+}
+
+func TestIsOffline(t *testing.T) {
+	offline := fmt.Errorf("failed to create worklog: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+	if !IsOffline(offline) {
+		t.Error("expected a dial error to be offline")
+	}
+	if IsOffline(errors.New("tempo API returned status 400")) {
+		t.Error("expected a server error not to be offline")
+	}
+	cancelled := &url.Error{Op: "Post", URL: "https://api.tempo.io/4/worklogs", Err: context.Canceled}
+	if IsOffline(cancelled) {
+		t.Error("expected a cancelled request not to be offline")
+	}
+}
+
+type fakeWriter struct {
+	errs    map[string]error // By issue key
+	written []string
+}
+
+func (w *fakeWriter) CreateWorklogRequest(request model.WorklogRequest) (*model.WorklogResponse, error) {
+	key := fmt.Sprintf("ISSUE-%d", request.IssueID)
+	if err := w.errs[key]; err != nil {
+		return nil, err
+	}
+	w.written = append(w.written, key)
+	return &model.WorklogResponse{}, nil
+}
+
+func (w *fakeWriter) TransitionIssue(issueKey, transitionID string) error {
+	if err := w.errs[issueKey]; err != nil {
+		return err
+	}
+	w.written = append(w.written, issueKey)
+	return nil
+}
+
+type fakeDeduper map[string]bool
+
+func (d fakeDeduper) Applied(entry Entry) (bool, error) {
+	return d[entry.IssueKey], nil
+}
+
+func TestFlushReplaysQueuedWrites(t *testing.T) {
+	store := NewStoreAt(filepath.Join(t.TempDir(), "outbox.json"))
+	for id := 1; id <= 3; id++ {
+		key := fmt.Sprintf("ISSUE-%d", id)
+		if _, err := store.Add(NewWorklogEntry(key, model.WorklogRequest{IssueID: id, TimeSpentSeconds: 3600})); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if _, err := store.Add(NewTransitionEntry("ISSUE-4", "31", "Done")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	writer := &fakeWriter{errs: map[string]error{"ISSUE-3": errors.New("tempo API returned status 400")}}
+	result, err := Flush(store, writer, fakeDeduper{"ISSUE-2": true})
+	if err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	want := FlushResult{Replayed: 2, Skipped: 1, Failed: 1, Remaining: 1}
+	if result != want {
+		t.Errorf("Flush() = %+v, want %+v", result, want)
+	}
+	entries, _ := store.List()
+	if len(entries) != 1 || entries[0].IssueKey != "ISSUE-3" || entries[0].Attempts != 1 || entries[0].LastError == "" {
+		t.Errorf("expected the rejected write to stay queued, got %+v", entries)
+	}
+}
+
+func TestFlushStopsWhileOffline(t *testing.T) {
+	store := NewStoreAt(filepath.Join(t.TempDir(), "outbox.json"))
+	for _, key := range []string{"ISSUE-1", "ISSUE-2"} {
+		if _, err := store.Add(NewTransitionEntry(key, "31", "Done")); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	writer := &fakeWriter{errs: map[string]error{"ISSUE-1": &net.DNSError{Err: "no such host", IsNotFound: true}}}
+	result, err := Flush(store, writer, nil)
+	if err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if !result.Offline || result.Remaining != 2 || len(writer.written) != 0 {
+		t.Errorf("expected the replay to stop at the first offline error, got %+v (written %v)", result, writer.written)
+	}
+}
+
+func TestIsAmbiguous(t *testing.T) {
+	dial := fmt.Errorf("failed to create worklog: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+	if IsAmbiguous(dial) {
+		t.Error("expected a failed connect not to be ambiguous")
+	}
+	if IsAmbiguous(&net.DNSError{Err: "no such host", IsNotFound: true}) {
+		t.Error("expected a failed DNS lookup not to be ambiguous")
+	}
+	read := fmt.Errorf("failed to create worklog: %w", &net.OpError{Op: "read", Err: errors.New("connection reset by peer")})
+	if !IsAmbiguous(read) {
+		t.Error("expected a connection that broke after sending to be ambiguous")
+	}
+	if IsAmbiguous(errors.New("tempo API returned status 400")) {
+		t.Error("expected a server error not to be ambiguous")
+	}
+}
+
+func TestStoreAddsFromSeveralProcesses(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+
+	// Separate stores stand in for the CLI and the TUI, which share only the file
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			store := NewStoreAt(path)
+			if _, err := store.Add(NewWorklogEntry("GRAP-1", model.WorklogRequest{IssueID: id, TimeSpentSeconds: 3600})); err != nil {
+				t.Errorf("Add() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	entries, err := NewStoreAt(path).List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 20 {
+		t.Errorf("expected 20 queued entries, got %d", len(entries))
+	}
+}
+
+func TestFlushSkipsWhileAnotherReplayRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	store := NewStoreAt(path)
+	if _, err := store.Add(NewTransitionEntry("ISSUE-1", "31", "Done")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	unlock, ok, err := NewStoreAt(path).lockFlush()
+	if err != nil || !ok {
+		t.Fatalf("lockFlush() = %v, %v", ok, err)
+	}
+	defer unlock()
+
+	writer := &fakeWriter{}
+	result, err := Flush(store, writer, nil)
+	if err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if !result.Busy || result.Remaining != 1 || len(writer.written) != 0 {
+		t.Errorf("expected the replay to leave the queue to the other process, got %+v (written %v)", result, writer.written)
+	}
+}
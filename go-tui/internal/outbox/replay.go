@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"fmt"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// Writer performs the queued writes
+type Writer interface {
+	CreateWorklogRequest(request model.WorklogRequest) (*model.WorklogResponse, error)
+	TransitionIssue(issueKey, transitionID string) error
+}
+
+// Deduper reports whether a queued write already reached the server (e.g. a request that
+// timed out after Tempo stored it), so replaying it would create a duplicate. A Deduper is
+// used for a single flush.
+type Deduper interface {
+	Applied(entry Entry) (bool, error)
+}
+
+// clientWriter sends writes through the Jira and Tempo clients
+type clientWriter struct {
+	jiraClient  *api.JiraClient
+	tempoClient *api.TempoClient
+}
+
+// NewWriter creates a Writer backed by the API clients
+func NewWriter(jiraClient *api.JiraClient, tempoClient *api.TempoClient) Writer {
+	return clientWriter{jiraClient: jiraClient, tempoClient: tempoClient}
+}
+
+func (w clientWriter) CreateWorklogRequest(request model.WorklogRequest) (*model.WorklogResponse, error) {
+	return w.tempoClient.CreateWorklogRequest(request)
+}
+
+func (w clientWriter) TransitionIssue(issueKey, transitionID string) error {
+	return w.jiraClient.TransitionIssue(issueKey, transitionID)
+}
+
+// FlushResult summarizes a replay of the outbox
+type FlushResult struct {
+	Replayed  int  // Entries written successfully
+	Skipped   int  // Entries dropped because they were already applied
+	Failed    int  // Entries the server rejected; they stay queued
+	Remaining int  // Entries still queued
+	Offline   bool // The replay stopped because the network is still down
+	Busy      bool // Another process was already replaying the outbox
+}
+
+// Flush replays queued entries oldest first. Entries are checked with dedup (if not nil)
+// and removed once written. The replay stops at the first network failure; entries the
+// server rejects stay queued with their error for "queue drop". Only one process replays
+// at a time; the others return right away with Busy set.
+func Flush(store *Store, writer Writer, dedup Deduper) (FlushResult, error) {
+	var result FlushResult
+
+	unlock, ok, err := store.lockFlush()
+	if err != nil {
+		return result, err
+	}
+	if !ok {
+		entries, err := store.List()
+		result.Busy = true
+		result.Remaining = len(entries)
+		return result, err
+	}
+	defer unlock()
+
+	entries, err := store.List()
+	if err != nil {
+		return result, err
+	}
+
+	for _, entry := range entries {
+		// Skip entries dropped since the replay started
+		entry, queued, err := store.get(entry.ID)
+		if err != nil {
+			return result, err
+		}
+		if !queued {
+			continue
+		}
+
+		if dedup != nil {
+			applied, err := dedup.Applied(entry)
+			if err != nil && IsOffline(err) {
+				result.Offline = true
+				break
+			}
+			if err == nil && applied {
+				if _, err := store.Remove(entry.ID); err != nil {
+					return result, err
+				}
+				result.Skipped++
+				continue
+			}
+		}
+
+		err = replay(writer, entry)
+		if err != nil && IsOffline(err) {
+			result.Offline = true
+			// The replay itself may have reached the server, so the next one checks first
+			if IsAmbiguous(err) {
+				entry.SetError(err)
+				if err := store.Update(entry); err != nil {
+					return result, err
+				}
+			}
+			break
+		}
+		if err != nil {
+			entry.Attempts++
+			entry.SetError(err)
+			if err := store.Update(entry); err != nil {
+				return result, err
+			}
+			result.Failed++
+			continue
+		}
+
+		if _, err := store.Remove(entry.ID); err != nil {
+			return result, err
+		}
+		result.Replayed++
+	}
+
+	remaining, err := store.List()
+	if err != nil {
+		return result, err
+	}
+	result.Remaining = len(remaining)
+	return result, nil
+}
+
+// replay performs a single queued write
+func replay(writer Writer, entry Entry) error {
+	switch {
+	case entry.Kind == KindWorklog && entry.Worklog != nil:
+		_, err := writer.CreateWorklogRequest(*entry.Worklog)
+		return err
+	case entry.Kind == KindTransition && entry.Transition != nil:
+		return writer.TransitionIssue(entry.IssueKey, entry.Transition.TransitionID)
+	}
+	return fmt.Errorf("unknown outbox entry kind %q", entry.Kind)
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// blockedByLink is the inward description of Jira's "Blocks" link type
+const blockedByLink = "is blocked by"
+
+// SetBlockers fills the Blockers section from issues that are flagged, in a blocked
+// status, or linked as "is blocked by" to an unresolved issue
+func (r *Report) SetBlockers(issues []model.Issue, blockedStatuses []string) {
+	r.Blockers = buildBlockers(issues, blockedStatuses)
+}
+
+// buildBlockers detects blocked issues, dropping duplicates and sorting by key
+func buildBlockers(issues []model.Issue, blockedStatuses []string) []Blocker {
+	blockers := []Blocker{}
+	for _, issue := range deduplicateIssues(issues) {
+		var reasons []string
+		if isFlagged(issue) {
+			reasons = append(reasons, "flagged")
+		}
+		if hasBlockedStatus(issue, blockedStatuses) {
+			reasons = append(reasons, "status "+issue.Fields.Status.Name)
+		}
+		blockedBy := unresolvedBlockers(issue)
+
+		if len(reasons) == 0 && len(blockedBy) == 0 {
+			continue
+		}
+		blockers = append(blockers, Blocker{
+			Key:       issue.Key,
+			Summary:   issue.Fields.Summary,
+			Reason:    strings.Join(reasons, ", "),
+			BlockedBy: blockedBy,
+		})
+	}
+
+	sort.Slice(blockers, func(i, j int) bool {
+		return blockers[i].Key < blockers[j].Key
+	})
+	return blockers
+}
+
+// isFlagged reports whether the issue carries the Flagged (Impediment) marker
+func isFlagged(issue model.Issue) bool {
+	return len(issue.Fields.Flagged) > 0
+}
+
+// hasBlockedStatus reports whether the issue's status is one of blockedStatuses (case-insensitive)
+func hasBlockedStatus(issue model.Issue, blockedStatuses []string) bool {
+	for _, s := range blockedStatuses {
+		if strings.EqualFold(issue.Fields.Status.Name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// unresolvedBlockers returns the keys of unresolved issues this issue "is blocked by"
+func unresolvedBlockers(issue model.Issue) []string {
+	var keys []string
+	for _, link := range issue.Fields.IssueLinks {
+		if link.InwardIssue == nil || !strings.EqualFold(link.Type.Inward, blockedByLink) {
+			continue
+		}
+		if category := link.InwardIssue.Fields.Status.StatusCategory; category != nil && category.Key == "done" {
+			continue
+		}
+		keys = append(keys, link.InwardIssue.Key)
+	}
+	return keys
+}
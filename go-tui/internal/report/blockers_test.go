@@ -0,0 +1,85 @@
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func blocksLink(key, categoryKey string) model.IssueLink {
+	return model.IssueLink{
+		Type: model.IssueLinkType{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+		InwardIssue: &model.LinkedIssue{
+			Key: key,
+			Fields: model.LinkedIssueFields{
+				Status: model.Status{Name: "Whatever", StatusCategory: &model.StatusCategory{Key: categoryKey}},
+			},
+		},
+	}
+}
+
+func TestBuildBlockers(t *testing.T) {
+	issues := []model.Issue{
+		{Key: "GRAP-4", Fields: model.IssueFields{Summary: "Clean", Status: model.Status{Name: "In Progress"}}},
+		{Key: "GRAP-3", Fields: model.IssueFields{Summary: "Waiting", IssueLinks: []model.IssueLink{
+			blocksLink("OPS-1", "indeterminate"),
+			blocksLink("OPS-2", "done"),
+			// Outward "blocks" links must not count
+			{Type: model.IssueLinkType{Inward: "is blocked by"}, OutwardIssue: &model.LinkedIssue{Key: "OPS-3"}},
+		}}},
+		{Key: "GRAP-2", Fields: model.IssueFields{Summary: "On hold", Status: model.Status{Name: "blocked"}}},
+		{Key: "GRAP-1", Fields: model.IssueFields{Summary: "Flagged", Flagged: []model.FlagOption{{Value: "Impediment"}}}},
+		// Duplicate key from another panel
+		{Key: "GRAP-1", Fields: model.IssueFields{Summary: "Flagged", Flagged: []model.FlagOption{{Value: "Impediment"}}}},
+		// Every blocker is already resolved
+		{Key: "GRAP-5", Fields: model.IssueFields{Summary: "Unblocked", IssueLinks: []model.IssueLink{blocksLink("OPS-4", "done")}}},
+	}
+
+	got := buildBlockers(issues, []string{"Blocked"})
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 blockers, got %+v", got)
+	}
+	if got[0].Key != "GRAP-1" || got[0].Reason != "flagged" {
+		t.Errorf("unexpected flagged blocker: %+v", got[0])
+	}
+	if got[1].Key != "GRAP-2" || got[1].Reason != "status blocked" {
+		t.Errorf("unexpected status blocker: %+v", got[1])
+	}
+	if got[2].Key != "GRAP-3" || len(got[2].BlockedBy) != 1 || got[2].BlockedBy[0] != "OPS-1" {
+		t.Errorf("expected GRAP-3 blocked by OPS-1 only, got %+v", got[2])
+	}
+}
+
+func TestIssueFieldsDecodeBlockerData(t *testing.T) {
+	raw := `{"key":"GRAP-1","fields":{"summary":"x",
+		"flagged":[{"value":"Impediment","id":"10019"}],
+		"issuelinks":[{"type":{"name":"Blocks","inward":"is blocked by","outward":"blocks"},
+			"inwardIssue":{"key":"OPS-1","fields":{"summary":"y","status":{"name":"To Do","statusCategory":{"key":"new"}}}}}]}}`
+
+	var issue model.Issue
+	if err := json.Unmarshal([]byte(raw), &issue); err != nil {
+		t.Fatalf("failed to decode issue: %v", err)
+	}
+
+	blockers := buildBlockers([]model.Issue{issue}, nil)
+	if len(blockers) != 1 || blockers[0].Reason != "flagged" || strings.Join(blockers[0].BlockedBy, ",") != "OPS-1" {
+		t.Errorf("unexpected blockers from decoded issue: %+v", blockers)
+	}
+}
+
+func TestTextReportListsBlockers(t *testing.T) {
+	r := NewReport(nil, nil, time.Time{})
+	r.SetBlockers([]model.Issue{{Key: "GRAP-1", Fields: model.IssueFields{Summary: "Flagged", Flagged: []model.FlagOption{{Value: "Impediment"}}}}}, nil)
+
+	out, err := TextRenderer{}.Render(r)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(out, "No blockers") || !strings.Contains(out, "GRAP-1") {
+		t.Errorf("expected GRAP-1 listed as a blocker, got:\n%s", out)
+	}
+}
@@ -1,9 +1,7 @@
 package report
 
 import (
-	"fmt"
 	"regexp"
-	"sort"
 	"strings"
 	"time"
 
@@ -12,140 +10,16 @@ import (
 
 var defaultDescriptionPattern = regexp.MustCompile(`(?i)^working on (?:issue |work item )?[a-z][a-z0-9]+-\d+$`)
 
+// BuildMainReport renders the Yesterday/Today/Blockers section as plain text
 func BuildMainReport(prevTasks []model.Worklog, inProgress []model.Issue, prevDate time.Time) string {
-	var sb strings.Builder
-
-	label := "Yesterday"
-	if !prevDate.IsZero() {
-		today := time.Now()
-		daysDiff := int(today.Sub(prevDate).Hours() / 24)
-
-		if daysDiff > 1 {
-			weekday := prevDate.Weekday().String()
-			label = fmt.Sprintf("Last %s", weekday)
-		}
-	}
-
-	sb.WriteString("Hi everyone,\n")
-	sb.WriteString(label + "\n")
-
-	groupedPrev := groupWorklogsByIssue(prevTasks)
-	if len(groupedPrev) > 0 {
-		// Get unique issue keys and sort them for consistent output
-		issueKeys := make([]string, 0, len(groupedPrev))
-		for key := range groupedPrev {
-			issueKeys = append(issueKeys, key)
-		}
-		sort.Strings(issueKeys)
-
-		for _, key := range issueKeys {
-			worklogs := groupedPrev[key]
-			if len(worklogs) == 0 {
-				continue
-			}
-
-			// Use the first worklog for issue details (all have same issue key/summary)
-			sb.WriteString(fmt.Sprintf("  ● %s: %s\n", worklogs[0].Issue.Key, worklogs[0].Issue.Summary))
-
-			// Display all non-default descriptions
-			for _, w := range worklogs {
-				if !isDefaultDescription(w.Description) {
-					sb.WriteString(fmt.Sprintf("     ○ %s\n", strings.TrimSpace(w.Description)))
-				}
-			}
-		}
-	} else {
-		sb.WriteString("  ● No tasks logged.\n")
-	}
-
-	sb.WriteString("Today\n")
-
-	uniqueInProgress := deduplicateIssues(inProgress)
-
-	if len(uniqueInProgress) > 0 {
-		for _, t := range uniqueInProgress {
-			sb.WriteString(fmt.Sprintf("  ● %s: %s\n", t.Key, t.Fields.Summary))
-		}
-	} else {
-		sb.WriteString("  ● No tasks planned.\n")
-	}
-
-	sb.WriteString("No blockers\n")
-
-	return sb.String()
+	out, _ := executeDefault("main", NewReport(prevTasks, inProgress, prevDate))
+	return out
 }
 
 // BuildTodoList builds the Todo section
 func BuildTodoList(todo []model.Issue, inProgress []model.Issue) string {
-	var sb strings.Builder
-	sb.WriteString("\n─────────────────────────────────────────────────────────────────\n\n")
-	sb.WriteString("Todo\n")
-
-	// Filter in-progress for stories
-	uniqueInProgress := deduplicateIssues(inProgress)
-	stories := filterStories(uniqueInProgress)
-	inProgressKeys := make(map[string]bool)
-	for _, s := range stories {
-		inProgressKeys[s.Key] = true
-	}
-
-	// Sort todo tasks
-	// Priority High > Medium > Low
-	// Type Bug > Task > Story
-	sort.Slice(todo, func(i, j int) bool {
-		p1 := getPriorityValue(todo[i].Fields.Priority)
-		p2 := getPriorityValue(todo[j].Fields.Priority)
-		if p1 != p2 {
-			return p1 > p2
-		}
-
-		t1 := getTypeValue(todo[i].Fields.IssueType.Name)
-		t2 := getTypeValue(todo[j].Fields.IssueType.Name)
-		if t1 != t2 {
-			return t1 > t2
-		}
-
-		return todo[i].Key < todo[j].Key
-	})
-
-	// Sort stories similarly
-	sort.Slice(stories, func(i, j int) bool {
-		p1 := getPriorityValue(stories[i].Fields.Priority)
-		p2 := getPriorityValue(stories[j].Fields.Priority)
-		return p1 > p2
-	})
-
-	// Combine: stories first if not strictly "todo", but original TS puts sorted open tasks.
-	// Replicating TS logic:
-	// "Combine: in-progress first (stories), then open tasks, limit to 10 total"
-	// But `stories` here are "In Progress Stories" specifically?
-	// TS logic: `const allTasks = [...sortedOpenTasks].slice(0, 10);`
-	// Wait, TS logic actually ignores `stories` in the main list concatenation?
-	// `const allTasks = [...sortedOpenTasks].slice(0, 10);` -> It only uses OpenTasks!
-	// But it uses `stories` to mark them?
-	// Ah, TS says: "Add in-progress indicator for stories/epics that are in progress" via `inProgressKeys`.
-
-	limit := 10
-	if len(todo) < limit {
-		limit = len(todo)
-	}
-	allTasks := todo[:limit]
-
-	if len(allTasks) == 0 {
-		sb.WriteString("- No tasks available.")
-		return sb.String()
-	}
-
-	for _, task := range allTasks {
-		icon := getIcon(task.Fields.IssueType.Name)
-		indicator := ""
-		if inProgressKeys[task.Key] {
-			indicator = "⏳ "
-		}
-		sb.WriteString(fmt.Sprintf(" %s %s%s: %s\n", icon, indicator, task.Key, task.Fields.Summary))
-	}
-
-	return sb.String()
+	out, _ := executeDefault("todo", &Report{Todo: buildTodoItems(todo, inProgress)})
+	return out
 }
 
 // Helpers
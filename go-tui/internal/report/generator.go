@@ -1,6 +1,7 @@
 package report
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -10,12 +11,21 @@ import (
 	"github.com/yourusername/jira-daily-report/internal/model"
 )
 
-// GenerateDailyReport generates the daily standup report
-func GenerateDailyReport(cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) (string, error) {
+// GenerateDailyReport generates the daily standup report as plain text
+func GenerateDailyReport(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) (string, error) {
+	data, err := FetchDailyReport(ctx, cfg, jiraClient, tempoClient)
+	if err != nil {
+		return "", err
+	}
+	return TextRenderer{}.Render(data)
+}
+
+// FetchDailyReport fetches tasks and worklogs and builds the structured report
+func FetchDailyReport(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) (*Report, error) {
 	// 1. Fetch current user
-	user, err := jiraClient.FetchCurrentUser()
+	user, err := jiraClient.FetchCurrentUserContext(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to fetch user: %w", err)
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
 	}
 
 	// 2. Fetch tasks (In Progress, Todo) independent of worklogs
@@ -28,36 +38,50 @@ func GenerateDailyReport(cfg *config.Manager, jiraClient *api.JiraClient, tempoC
 	}
 	inProgressChan := make(chan taskResult)
 	todoChan := make(chan taskResult)
+	blockedChan := make(chan taskResult)
 
 	go func() {
-		issues, err := jiraClient.FetchInProgressTasks(username)
+		issues, err := jiraClient.FetchInProgressTasksContext(ctx, username, cfg.GetStatusMapping())
 		inProgressChan <- taskResult{issues, err}
 	}()
 
 	go func() {
-		issues, err := jiraClient.FetchOpenTasks(username)
+		issues, err := jiraClient.FetchOpenTasksContext(ctx, username, cfg.GetStatusMapping())
 		todoChan <- taskResult{issues, err}
 	}()
 
+	go func() {
+		issues, err := jiraClient.FetchBlockedTasksContext(ctx, username, cfg.GetBlockedStatuses())
+		blockedChan <- taskResult{issues, err}
+	}()
+
 	inProgressRes := <-inProgressChan
 	if inProgressRes.err != nil {
-		return "", fmt.Errorf("failed to fetch in-progress tasks: %w", inProgressRes.err)
+		return nil, fmt.Errorf("failed to fetch in-progress tasks: %w", inProgressRes.err)
 	}
 
 	todoRes := <-todoChan
 	if todoRes.err != nil {
-		return "", fmt.Errorf("failed to fetch todo tasks: %w", todoRes.err)
+		return nil, fmt.Errorf("failed to fetch todo tasks: %w", todoRes.err)
+	}
+
+	// Blockers are best-effort: the JQL fails if a configured status does not exist,
+	// so fall back to detecting them among the tasks we already have
+	blockedRes := <-blockedChan
+	blockerCandidates := blockedRes.issues
+	if blockedRes.err != nil {
+		blockerCandidates = append(append([]model.Issue{}, inProgressRes.issues...), todoRes.issues...)
 	}
 
 	// 3. Fetch Worklogs (last 6 days to find previous workday)
-	worklogs, err := tempoClient.FetchLastSixDaysWorklogs(user.AccountID)
+	worklogs, err := tempoClient.FetchLastSixDaysWorklogsContext(ctx, user.AccountID)
 	if err != nil {
-		return "", fmt.Errorf("failed to fetch worklogs: %w", err)
+		return nil, fmt.Errorf("failed to fetch worklogs: %w", err)
 	}
 
-	enrichedWorklogs, err := tempoClient.EnrichWorklogsWithIssueDetails(worklogs)
+	enrichedWorklogs, err := tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, worklogs)
 	if err != nil {
-		return "", fmt.Errorf("failed to enrich worklogs: %w", err)
+		return nil, fmt.Errorf("failed to enrich worklogs: %w", err)
 	}
 
 	// 4. Extract Previous Workday Tasks
@@ -65,13 +89,12 @@ func GenerateDailyReport(cfg *config.Manager, jiraClient *api.JiraClient, tempoC
 	_ = prevDate // We can use this to make a smart label if needed "Yesterday (Tue)" etc.
 
 	// 5. Build Report
-	report := BuildMainReport(prevWorkdayTasks, inProgressRes.issues, prevDate)
-	todoList := BuildTodoList(todoRes.issues, inProgressRes.issues)
+	report := NewReport(prevWorkdayTasks, inProgressRes.issues, prevDate)
+	report.SetTodo(todoRes.issues, inProgressRes.issues)
+	report.SetBlockers(blockerCandidates, cfg.GetBlockedStatuses())
+	report.BaseURL = cfg.GetJiraServer()
 
-	// Combine
-	finalReport := report + todoList
-
-	return finalReport, nil
+	return report, nil
 }
 
 // extractPreviousWorkdayTasks groups worklogs by date and returns tasks from the most recent previous workday
@@ -102,3 +125,30 @@ func extractPreviousWorkdayTasks(worklogs []model.Worklog) ([]model.Worklog, tim
 
 	return []model.Worklog{}, time.Time{}
 }
+
+// FetchSummary fetches the current user's worklogs between from and until (YYYY-MM-DD, inclusive)
+// and aggregates them into a range summary
+func FetchSummary(ctx context.Context, cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient, from, until string) (*Summary, error) {
+	if from > until {
+		return nil, fmt.Errorf("start date %s is after end date %s", from, until)
+	}
+
+	user, err := jiraClient.FetchCurrentUserContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	worklogs, err := tempoClient.FetchWorklogsContext(ctx, user.AccountID, from, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch worklogs: %w", err)
+	}
+
+	enrichedWorklogs, err := tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, worklogs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enrich worklogs: %w", err)
+	}
+
+	summary := NewSummary(enrichedWorklogs, from, until)
+	summary.BaseURL = cfg.GetJiraServer()
+	return summary, nil
+}
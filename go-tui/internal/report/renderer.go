@@ -0,0 +1,310 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Format identifies an output format for a rendered report
+type Format string
+
+const (
+	FormatText     Format = "text"
+	FormatMarkdown Format = "markdown"
+	FormatSlack    Format = "slack"
+	FormatHTML     Format = "html"
+	FormatJSON     Format = "json"
+)
+
+// Formats lists every supported format in display order
+var Formats = []Format{FormatText, FormatMarkdown, FormatSlack, FormatHTML, FormatJSON}
+
+// FormatHelp is a short description of the supported formats for flag help
+const FormatHelp = "text, markdown, slack, html, json"
+
+// Renderer turns a structured report into its final textual form
+type Renderer interface {
+	Render(r *Report) (string, error)
+}
+
+// ParseFormat resolves a user-supplied format name (case-insensitive, with aliases)
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "text", "txt", "plain":
+		return FormatText, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "slack", "mrkdwn":
+		return FormatSlack, nil
+	case "html":
+		return FormatHTML, nil
+	case "json":
+		return FormatJSON, nil
+	}
+	return "", fmt.Errorf("unsupported format %q (use: %s)", s, FormatHelp)
+}
+
+// NewRenderer returns the renderer for a format
+func NewRenderer(format Format) (Renderer, error) {
+	switch format {
+	case FormatText:
+		return TextRenderer{}, nil
+	case FormatMarkdown:
+		return MarkdownRenderer{}, nil
+	case FormatSlack:
+		return SlackRenderer{}, nil
+	case FormatHTML:
+		return HTMLRenderer{}, nil
+	case FormatJSON:
+		return JSONRenderer{}, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q (use: %s)", format, FormatHelp)
+}
+
+// Render is a convenience wrapper that renders a report in the given format
+func Render(r *Report, format Format) (string, error) {
+	renderer, err := NewRenderer(format)
+	if err != nil {
+		return "", err
+	}
+	return renderer.Render(r)
+}
+
+// NextFormat returns the format after f in Formats, wrapping around
+func NextFormat(f Format) Format {
+	for i, candidate := range Formats {
+		if candidate == f {
+			return Formats[(i+1)%len(Formats)]
+		}
+	}
+	return FormatText
+}
+
+// TextRenderer renders the classic bullet-point standup text using DefaultTemplate
+type TextRenderer struct{}
+
+// Render implements Renderer
+func (TextRenderer) Render(r *Report) (string, error) {
+	return executeDefault("report", r)
+}
+
+// MarkdownRenderer renders GitHub/Confluence-flavoured Markdown
+type MarkdownRenderer struct{}
+
+// Render implements Renderer
+func (MarkdownRenderer) Render(r *Report) (string, error) {
+	var sb strings.Builder
+	link := func(key string) string {
+		if url := r.IssueURL(key); url != "" {
+			return fmt.Sprintf("[%s](%s)", key, url)
+		}
+		return "**" + key + "**"
+	}
+
+	sb.WriteString("Hi everyone,\n\n")
+	sb.WriteString("### " + r.PreviousLabel + "\n\n")
+	if len(r.Yesterday) == 0 {
+		sb.WriteString("- No tasks logged.\n")
+	}
+	for _, item := range r.Yesterday {
+		sb.WriteString(fmt.Sprintf("- %s: %s\n", link(item.Key), item.Summary))
+		for _, note := range item.Notes {
+			sb.WriteString(fmt.Sprintf("  - %s\n", note))
+		}
+	}
+
+	sb.WriteString("\n### Today\n\n")
+	if len(r.Today) == 0 {
+		sb.WriteString("- No tasks planned.\n")
+	}
+	for _, item := range r.Today {
+		sb.WriteString(fmt.Sprintf("- %s: %s\n", link(item.Key), item.Summary))
+	}
+
+	sb.WriteString("\n### Blockers\n\n")
+	if len(r.Blockers) == 0 {
+		sb.WriteString("- No blockers\n")
+	}
+	for _, b := range r.Blockers {
+		sb.WriteString(fmt.Sprintf("- %s: %s%s\n", link(b.Key), b.Summary, blockerSuffix(b)))
+	}
+
+	if r.IncludeTodo {
+		sb.WriteString("\n### Todo\n\n")
+		if len(r.Todo) == 0 {
+			sb.WriteString("- No tasks available.\n")
+		}
+		for _, task := range r.Todo {
+			indicator := ""
+			if task.InProgress {
+				indicator = " _(in progress)_"
+			}
+			sb.WriteString(fmt.Sprintf("- %s %s: %s%s\n", getIcon(task.IssueType), link(task.Key), task.Summary, indicator))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// SlackRenderer renders Slack mrkdwn
+type SlackRenderer struct{}
+
+// Render implements Renderer
+func (SlackRenderer) Render(r *Report) (string, error) {
+	var sb strings.Builder
+	link := func(key string) string {
+		if url := r.IssueURL(key); url != "" {
+			return fmt.Sprintf("<%s|%s>", url, key)
+		}
+		return "*" + key + "*"
+	}
+
+	sb.WriteString("Hi everyone,\n")
+	sb.WriteString("*" + r.PreviousLabel + "*\n")
+	if len(r.Yesterday) == 0 {
+		sb.WriteString("• No tasks logged.\n")
+	}
+	for _, item := range r.Yesterday {
+		sb.WriteString(fmt.Sprintf("• %s: %s\n", link(item.Key), slackEscape(item.Summary)))
+		for _, note := range item.Notes {
+			sb.WriteString(fmt.Sprintf("    ◦ %s\n", slackEscape(note)))
+		}
+	}
+
+	sb.WriteString("*Today*\n")
+	if len(r.Today) == 0 {
+		sb.WriteString("• No tasks planned.\n")
+	}
+	for _, item := range r.Today {
+		sb.WriteString(fmt.Sprintf("• %s: %s\n", link(item.Key), slackEscape(item.Summary)))
+	}
+
+	if len(r.Blockers) == 0 {
+		sb.WriteString("*Blockers*: none\n")
+	} else {
+		sb.WriteString("*Blockers*\n")
+		for _, b := range r.Blockers {
+			sb.WriteString(fmt.Sprintf("• %s: %s%s\n", link(b.Key), slackEscape(b.Summary), slackEscape(blockerSuffix(b))))
+		}
+	}
+
+	if r.IncludeTodo {
+		sb.WriteString("\n*Todo*\n")
+		if len(r.Todo) == 0 {
+			sb.WriteString("• No tasks available.\n")
+		}
+		for _, task := range r.Todo {
+			indicator := ""
+			if task.InProgress {
+				indicator = " _(in progress)_"
+			}
+			sb.WriteString(fmt.Sprintf("%s %s: %s%s\n", getIcon(task.IssueType), link(task.Key), slackEscape(task.Summary), indicator))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// slackEscape escapes the control characters Slack reserves in mrkdwn
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// HTMLRenderer renders an HTML fragment suitable for pasting into Confluence or email
+type HTMLRenderer struct{}
+
+// Render implements Renderer
+func (HTMLRenderer) Render(r *Report) (string, error) {
+	var sb strings.Builder
+	link := func(key string) string {
+		if url := r.IssueURL(key); url != "" {
+			return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(key))
+		}
+		return "<strong>" + html.EscapeString(key) + "</strong>"
+	}
+	item := func(key, summary string) string {
+		return fmt.Sprintf("%s: %s", link(key), html.EscapeString(summary))
+	}
+
+	sb.WriteString("<p>Hi everyone,</p>\n")
+	sb.WriteString("<h3>" + html.EscapeString(r.PreviousLabel) + "</h3>\n<ul>\n")
+	if len(r.Yesterday) == 0 {
+		sb.WriteString("  <li>No tasks logged.</li>\n")
+	}
+	for _, it := range r.Yesterday {
+		sb.WriteString("  <li>" + item(it.Key, it.Summary))
+		if len(it.Notes) > 0 {
+			sb.WriteString("\n    <ul>\n")
+			for _, note := range it.Notes {
+				sb.WriteString("      <li>" + html.EscapeString(note) + "</li>\n")
+			}
+			sb.WriteString("    </ul>\n  ")
+		}
+		sb.WriteString("</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	sb.WriteString("<h3>Today</h3>\n<ul>\n")
+	if len(r.Today) == 0 {
+		sb.WriteString("  <li>No tasks planned.</li>\n")
+	}
+	for _, it := range r.Today {
+		sb.WriteString("  <li>" + item(it.Key, it.Summary) + "</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	sb.WriteString("<h3>Blockers</h3>\n<ul>\n")
+	if len(r.Blockers) == 0 {
+		sb.WriteString("  <li>No blockers</li>\n")
+	}
+	for _, b := range r.Blockers {
+		sb.WriteString("  <li>" + item(b.Key, b.Summary) + html.EscapeString(blockerSuffix(b)) + "</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	if r.IncludeTodo {
+		sb.WriteString("<h3>Todo</h3>\n<ul>\n")
+		if len(r.Todo) == 0 {
+			sb.WriteString("  <li>No tasks available.</li>\n")
+		}
+		for _, task := range r.Todo {
+			indicator := ""
+			if task.InProgress {
+				indicator = " <em>(in progress)</em>"
+			}
+			sb.WriteString("  <li>" + getIcon(task.IssueType) + " " + item(task.Key, task.Summary) + indicator + "</li>\n")
+		}
+		sb.WriteString("</ul>\n")
+	}
+
+	return sb.String(), nil
+}
+
+// JSONRenderer renders the report model as indented JSON for bots and scripts
+type JSONRenderer struct{}
+
+// Render implements Renderer
+func (JSONRenderer) Render(r *Report) (string, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report: %w", err)
+	}
+	return string(data) + "\n", nil
+}
+
+// blockerSuffix describes why an issue is blocked, e.g. " (blocked by ABC-1, ABC-2)"
+func blockerSuffix(b Blocker) string {
+	var parts []string
+	if b.Reason != "" {
+		parts = append(parts, b.Reason)
+	}
+	if len(b.BlockedBy) > 0 {
+		parts = append(parts, "blocked by "+strings.Join(b.BlockedBy, ", "))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, "; ") + ")"
+}
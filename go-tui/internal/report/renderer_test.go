@@ -0,0 +1,152 @@
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func testReport() *Report {
+	prevTasks := []model.Worklog{
+		{
+			Issue:       model.WorklogIssue{Key: "GRAP-1", Summary: "Fix <login> & logout"},
+			Description: "do task A",
+		},
+		{
+			Issue:       model.WorklogIssue{Key: "GRAP-1", Summary: "Fix <login> & logout"},
+			Description: "working on issue GRAP-1",
+		},
+	}
+	inProgress := []model.Issue{
+		{Key: "GRAP-2", Fields: model.IssueFields{Summary: "Build renderer", IssueType: model.IssueType{Name: "Story"}}},
+	}
+	todo := []model.Issue{
+		{Key: "GRAP-3", Fields: model.IssueFields{Summary: "Low task", IssueType: model.IssueType{Name: "Task"}}},
+		{Key: "GRAP-4", Fields: model.IssueFields{Summary: "Urgent bug", IssueType: model.IssueType{Name: "Bug"}, Priority: &model.Priority{Name: "High"}}},
+	}
+
+	r := NewReport(prevTasks, inProgress, time.Now().AddDate(0, 0, -1))
+	r.SetTodo(todo, inProgress)
+	r.BaseURL = "https://example.atlassian.net/"
+	return r
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Format
+	}{
+		{in: "", want: FormatText},
+		{in: "TEXT", want: FormatText},
+		{in: "md", want: FormatMarkdown},
+		{in: "mrkdwn", want: FormatSlack},
+		{in: "html", want: FormatHTML},
+		{in: " json ", want: FormatJSON},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.in)
+		if err != nil {
+			t.Fatalf("ParseFormat(%q) error = %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseFormat(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := ParseFormat("pdf"); err == nil {
+		t.Error("ParseFormat(\"pdf\") error = nil, want error")
+	}
+}
+
+func TestTextRendererMatchesLegacyBuilders(t *testing.T) {
+	prevDate := time.Now().AddDate(0, 0, -1)
+	prevTasks := []model.Worklog{{Issue: model.WorklogIssue{Key: "GRAP-1", Summary: "One"}, Description: "note"}}
+	inProgress := []model.Issue{{Key: "GRAP-2", Fields: model.IssueFields{Summary: "Two"}}}
+	todo := []model.Issue{{Key: "GRAP-3", Fields: model.IssueFields{Summary: "Three", IssueType: model.IssueType{Name: "Bug"}}}}
+
+	want := BuildMainReport(prevTasks, inProgress, prevDate) + BuildTodoList(todo, inProgress)
+
+	r := NewReport(prevTasks, inProgress, prevDate)
+	r.SetTodo(todo, inProgress)
+	got, err := TextRenderer{}.Render(r)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("TextRenderer output differs from legacy builders:\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestMarkdownRendererLinksIssues(t *testing.T) {
+	out, err := MarkdownRenderer{}.Render(testReport())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if !strings.Contains(out, "- [GRAP-1](https://example.atlassian.net/browse/GRAP-1): Fix <login> & logout") {
+		t.Errorf("expected linked yesterday item, got:\n%s", out)
+	}
+	if !strings.Contains(out, "  - do task A") {
+		t.Error("expected nested note for custom description")
+	}
+	if strings.Contains(out, "working on issue") {
+		t.Error("default descriptions should not be rendered")
+	}
+	if !strings.Contains(out, "### Todo") {
+		t.Error("expected Todo section")
+	}
+}
+
+func TestSlackRendererEscapesAndLinks(t *testing.T) {
+	out, err := SlackRenderer{}.Render(testReport())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if !strings.Contains(out, "<https://example.atlassian.net/browse/GRAP-2|GRAP-2>") {
+		t.Errorf("expected Slack link, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Fix &lt;login&gt; &amp; logout") {
+		t.Error("expected Slack control characters to be escaped")
+	}
+}
+
+func TestHTMLRendererEscapes(t *testing.T) {
+	out, err := HTMLRenderer{}.Render(testReport())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if strings.Contains(out, "<login>") {
+		t.Error("summary must be HTML-escaped")
+	}
+	if !strings.Contains(out, `<a href="https://example.atlassian.net/browse/GRAP-1">GRAP-1</a>`) {
+		t.Errorf("expected HTML link, got:\n%s", out)
+	}
+}
+
+func TestJSONRendererRoundTrip(t *testing.T) {
+	out, err := JSONRenderer{}.Render(testReport())
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var decoded Report
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	if len(decoded.Yesterday) != 1 || decoded.Yesterday[0].Key != "GRAP-1" {
+		t.Errorf("unexpected yesterday items: %+v", decoded.Yesterday)
+	}
+	if len(decoded.Todo) != 2 || decoded.Todo[0].Key != "GRAP-4" {
+		t.Errorf("expected high-priority bug first in todo, got %+v", decoded.Todo)
+	}
+	if decoded.Blockers == nil {
+		t.Error("blockers should be an empty list, not null")
+	}
+}
@@ -0,0 +1,194 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// Report is the structured daily report consumed by every renderer.
+// It is also the data passed to report templates (see DefaultTemplate).
+type Report struct {
+	GeneratedAt   time.Time  `json:"generatedAt"`
+	PreviousDate  string     `json:"previousDate,omitempty"` // YYYY-MM-DD
+	PreviousLabel string     `json:"previousLabel"`
+	Yesterday     []Item     `json:"yesterday"`
+	Today         []Item     `json:"today"`
+	Todo          []TodoItem `json:"todo,omitempty"`
+	Blockers      []Blocker  `json:"blockers"`
+	TotalSeconds  int        `json:"totalSeconds"` // Time logged on the previous workday
+
+	// Worklogs are the raw previous-workday worklogs behind Yesterday
+	Worklogs []model.Worklog `json:"-"`
+	// InProgress are the raw issues behind Today
+	InProgress []model.Issue `json:"-"`
+
+	// IncludeTodo controls whether renderers output the Todo section
+	IncludeTodo bool `json:"-"`
+	// BaseURL is the Jira server used to build issue links (optional)
+	BaseURL string `json:"-"`
+}
+
+// Item is a single issue line in the Yesterday/Today sections
+type Item struct {
+	Key              string   `json:"key"`
+	Summary          string   `json:"summary"`
+	Notes            []string `json:"notes,omitempty"`
+	TimeSpentSeconds int      `json:"timeSpentSeconds,omitempty"`
+}
+
+// TodoItem is a single issue line in the Todo section
+type TodoItem struct {
+	Key        string `json:"key"`
+	Summary    string `json:"summary"`
+	IssueType  string `json:"issueType"`
+	Priority   string `json:"priority,omitempty"`
+	InProgress bool   `json:"inProgress,omitempty"`
+}
+
+// Blocker is an issue that is currently blocked
+type Blocker struct {
+	Key       string   `json:"key"`
+	Summary   string   `json:"summary"`
+	Reason    string   `json:"reason,omitempty"`
+	BlockedBy []string `json:"blockedBy,omitempty"`
+}
+
+// NewReport builds the main report sections (previous workday and today)
+func NewReport(prevTasks []model.Worklog, inProgress []model.Issue, prevDate time.Time) *Report {
+	now := time.Now()
+	r := &Report{
+		GeneratedAt:   now,
+		PreviousLabel: previousDayLabel(prevDate, now),
+		Yesterday:     buildWorklogItems(prevTasks),
+		Today:         buildIssueItems(inProgress),
+		Blockers:      []Blocker{},
+		Worklogs:      prevTasks,
+		InProgress:    inProgress,
+	}
+	for _, w := range prevTasks {
+		r.TotalSeconds += w.TimeSpentSeconds
+	}
+	if !prevDate.IsZero() {
+		r.PreviousDate = prevDate.Format("2006-01-02")
+	}
+	return r
+}
+
+// SetTodo fills the Todo section from open tasks, marking in-progress stories
+func (r *Report) SetTodo(todo []model.Issue, inProgress []model.Issue) {
+	r.Todo = buildTodoItems(todo, inProgress)
+	r.IncludeTodo = true
+}
+
+// IssueURL returns the browse URL for an issue key, or empty if no base URL is set
+func (r *Report) IssueURL(key string) string {
+	if r.BaseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/browse/%s", strings.TrimSuffix(r.BaseURL, "/"), key)
+}
+
+// previousDayLabel returns "Yesterday" or "Last <Weekday>" for older dates
+func previousDayLabel(prevDate, now time.Time) string {
+	if prevDate.IsZero() {
+		return "Yesterday"
+	}
+	daysDiff := int(now.Sub(prevDate).Hours() / 24)
+	if daysDiff > 1 {
+		return fmt.Sprintf("Last %s", prevDate.Weekday().String())
+	}
+	return "Yesterday"
+}
+
+// buildWorklogItems groups worklogs by issue and keeps non-default descriptions as notes
+func buildWorklogItems(worklogs []model.Worklog) []Item {
+	grouped := groupWorklogsByIssue(worklogs)
+
+	// Get unique issue keys and sort them for consistent output
+	issueKeys := make([]string, 0, len(grouped))
+	for key := range grouped {
+		issueKeys = append(issueKeys, key)
+	}
+	sort.Strings(issueKeys)
+
+	items := []Item{}
+	for _, key := range issueKeys {
+		logs := grouped[key]
+		if len(logs) == 0 {
+			continue
+		}
+
+		// Use the first worklog for issue details (all have same issue key/summary)
+		item := Item{Key: logs[0].Issue.Key, Summary: logs[0].Issue.Summary}
+		for _, w := range logs {
+			item.TimeSpentSeconds += w.TimeSpentSeconds
+			if !isDefaultDescription(w.Description) {
+				item.Notes = append(item.Notes, strings.TrimSpace(w.Description))
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// buildIssueItems converts issues into report items, dropping duplicates
+func buildIssueItems(issues []model.Issue) []Item {
+	items := []Item{}
+	for _, t := range deduplicateIssues(issues) {
+		items = append(items, Item{Key: t.Key, Summary: t.Fields.Summary})
+	}
+	return items
+}
+
+// buildTodoItems sorts open tasks by priority and type and keeps the top 10
+func buildTodoItems(todo []model.Issue, inProgress []model.Issue) []TodoItem {
+	// Filter in-progress for stories
+	stories := filterStories(deduplicateIssues(inProgress))
+	inProgressKeys := make(map[string]bool)
+	for _, s := range stories {
+		inProgressKeys[s.Key] = true
+	}
+
+	// Sort todo tasks
+	// Priority High > Medium > Low
+	// Type Bug > Task > Story
+	sort.Slice(todo, func(i, j int) bool {
+		p1 := getPriorityValue(todo[i].Fields.Priority)
+		p2 := getPriorityValue(todo[j].Fields.Priority)
+		if p1 != p2 {
+			return p1 > p2
+		}
+
+		t1 := getTypeValue(todo[i].Fields.IssueType.Name)
+		t2 := getTypeValue(todo[j].Fields.IssueType.Name)
+		if t1 != t2 {
+			return t1 > t2
+		}
+
+		return todo[i].Key < todo[j].Key
+	})
+
+	limit := 10
+	if len(todo) < limit {
+		limit = len(todo)
+	}
+
+	items := []TodoItem{}
+	for _, task := range todo[:limit] {
+		item := TodoItem{
+			Key:        task.Key,
+			Summary:    task.Fields.Summary,
+			IssueType:  task.Fields.IssueType.Name,
+			InProgress: inProgressKeys[task.Key],
+		}
+		if task.Fields.Priority != nil {
+			item.Priority = task.Fields.Priority.Name
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,311 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// noEpicLabel is the group label for issues that have no parent epic
+const noEpicLabel = "No epic"
+
+// Summary aggregates worklogs over a date range, grouped by day, issue and epic
+type Summary struct {
+	GeneratedAt  time.Time      `json:"generatedAt"`
+	From         string         `json:"from"`  // YYYY-MM-DD, inclusive
+	Until        string         `json:"until"` // YYYY-MM-DD, inclusive
+	TotalSeconds int            `json:"totalSeconds"`
+	Days         []DaySummary   `json:"days"`
+	Issues       []IssueSummary `json:"issues"`
+	Epics        []EpicSummary  `json:"epics"`
+
+	// BaseURL is the Jira server used to build issue links (optional)
+	BaseURL string `json:"-"`
+}
+
+// DaySummary is the time logged on a single day
+type DaySummary struct {
+	Date         string         `json:"date"` // YYYY-MM-DD
+	Weekday      string         `json:"weekday"`
+	TotalSeconds int            `json:"totalSeconds"`
+	Issues       []IssueSummary `json:"issues"`
+}
+
+// IssueSummary is the time logged on a single issue
+type IssueSummary struct {
+	Key          string   `json:"key"`
+	Summary      string   `json:"summary"`
+	EpicKey      string   `json:"epicKey,omitempty"`
+	TotalSeconds int      `json:"totalSeconds"`
+	Notes        []string `json:"notes,omitempty"`
+}
+
+// EpicSummary is the time logged on the issues under a parent epic
+type EpicSummary struct {
+	Key          string         `json:"key,omitempty"` // Empty for issues without an epic
+	Summary      string         `json:"summary"`
+	TotalSeconds int            `json:"totalSeconds"`
+	Issues       []IssueSummary `json:"issues"`
+}
+
+// NewSummary aggregates enriched worklogs between from and until (YYYY-MM-DD, inclusive)
+func NewSummary(worklogs []model.Worklog, from, until string) *Summary {
+	s := &Summary{
+		GeneratedAt: time.Now(),
+		From:        from,
+		Until:       until,
+		Days:        []DaySummary{},
+		Issues:      []IssueSummary{},
+		Epics:       []EpicSummary{},
+	}
+
+	byDate := make(map[string][]model.Worklog)
+	for _, w := range worklogs {
+		if w.StartDate < from || w.StartDate > until {
+			continue
+		}
+		byDate[w.StartDate] = append(byDate[w.StartDate], w)
+		s.TotalSeconds += w.TimeSpentSeconds
+	}
+
+	dates := make([]string, 0, len(byDate))
+	for d := range byDate {
+		dates = append(dates, d)
+	}
+	sort.Strings(dates)
+
+	var inRange []model.Worklog
+	for _, d := range dates {
+		day := DaySummary{Date: d, Issues: summarizeIssues(byDate[d])}
+		if t, err := time.Parse("2006-01-02", d); err == nil {
+			day.Weekday = t.Weekday().String()
+		}
+		for _, w := range byDate[d] {
+			day.TotalSeconds += w.TimeSpentSeconds
+		}
+		s.Days = append(s.Days, day)
+		inRange = append(inRange, byDate[d]...)
+	}
+
+	s.Issues = summarizeIssues(inRange)
+	s.Epics = summarizeEpics(inRange, s.Issues)
+	return s
+}
+
+// IssueURL returns the browse URL for an issue key, or empty if no base URL is set
+func (s *Summary) IssueURL(key string) string {
+	return (&Report{BaseURL: s.BaseURL}).IssueURL(key)
+}
+
+// summarizeIssues totals worklogs per issue, sorted by time spent (descending) then key
+func summarizeIssues(worklogs []model.Worklog) []IssueSummary {
+	grouped := groupWorklogsByIssue(worklogs)
+
+	issues := []IssueSummary{}
+	for _, logs := range grouped {
+		issue := IssueSummary{
+			Key:     logs[0].Issue.Key,
+			Summary: logs[0].Issue.Summary,
+			EpicKey: logs[0].Issue.EpicKey,
+		}
+		seen := make(map[string]bool)
+		for _, w := range logs {
+			issue.TotalSeconds += w.TimeSpentSeconds
+			note := strings.TrimSpace(w.Description)
+			if !isDefaultDescription(w.Description) && !seen[note] {
+				seen[note] = true
+				issue.Notes = append(issue.Notes, note)
+			}
+		}
+		issues = append(issues, issue)
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].TotalSeconds != issues[j].TotalSeconds {
+			return issues[i].TotalSeconds > issues[j].TotalSeconds
+		}
+		return issues[i].Key < issues[j].Key
+	})
+	return issues
+}
+
+// summarizeEpics groups issue totals under their parent epic; issues without one share a "No epic" group
+func summarizeEpics(worklogs []model.Worklog, issues []IssueSummary) []EpicSummary {
+	epicNames := make(map[string]string)
+	for _, w := range worklogs {
+		if w.Issue.EpicKey != "" {
+			epicNames[w.Issue.EpicKey] = w.Issue.EpicSummary
+		}
+	}
+
+	index := make(map[string]int)
+	epics := []EpicSummary{}
+	for _, issue := range issues {
+		i, ok := index[issue.EpicKey]
+		if !ok {
+			summary := epicNames[issue.EpicKey]
+			if issue.EpicKey == "" {
+				summary = noEpicLabel
+			}
+			epics = append(epics, EpicSummary{Key: issue.EpicKey, Summary: summary, Issues: []IssueSummary{}})
+			i = len(epics) - 1
+			index[issue.EpicKey] = i
+		}
+		epics[i].TotalSeconds += issue.TotalSeconds
+		epics[i].Issues = append(epics[i].Issues, issue)
+	}
+
+	// Largest epics first, "No epic" always last
+	sort.SliceStable(epics, func(i, j int) bool {
+		if (epics[i].Key == "") != (epics[j].Key == "") {
+			return epics[j].Key == ""
+		}
+		return epics[i].TotalSeconds > epics[j].TotalSeconds
+	})
+	return epics
+}
+
+// RenderSummary renders a range summary in the given format
+func RenderSummary(s *Summary, format Format) (string, error) {
+	switch format {
+	case FormatText:
+		return renderSummaryLines(s, summaryStyle{
+			heading: func(t string) string { return t + ":" },
+			bullet:  "- ",
+			nested:  "  - ",
+			link:    func(key string) string { return key },
+			escape:  func(t string) string { return t },
+		}), nil
+	case FormatMarkdown:
+		return renderSummaryLines(s, summaryStyle{
+			heading: func(t string) string { return "### " + t + "\n" },
+			bullet:  "- ",
+			nested:  "  - ",
+			link: func(key string) string {
+				if url := s.IssueURL(key); url != "" {
+					return fmt.Sprintf("[%s](%s)", key, url)
+				}
+				return "**" + key + "**"
+			},
+			escape: func(t string) string { return t },
+		}), nil
+	case FormatSlack:
+		return renderSummaryLines(s, summaryStyle{
+			heading: func(t string) string { return "*" + t + "*" },
+			bullet:  "• ",
+			nested:  "    ◦ ",
+			link: func(key string) string {
+				if url := s.IssueURL(key); url != "" {
+					return fmt.Sprintf("<%s|%s>", url, key)
+				}
+				return "*" + key + "*"
+			},
+			escape: slackEscape,
+		}), nil
+	case FormatHTML:
+		return renderSummaryHTML(s), nil
+	case FormatJSON:
+		data, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal summary: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+	return "", fmt.Errorf("unsupported format %q (use: %s)", format, FormatHelp)
+}
+
+// summaryStyle holds the markup used by the line-based summary formats
+type summaryStyle struct {
+	heading func(string) string
+	bullet  string
+	nested  string
+	link    func(string) string
+	escape  func(string) string
+}
+
+// renderSummaryLines renders the text, markdown and Slack variants of a summary
+func renderSummaryLines(s *Summary, st summaryStyle) string {
+	var sb strings.Builder
+	issueLine := func(prefix string, issue IssueSummary) {
+		sb.WriteString(fmt.Sprintf("%s%s: %s (%s)\n", prefix, st.link(issue.Key), st.escape(issue.Summary), formatHours(issue.TotalSeconds)))
+	}
+
+	sb.WriteString(st.heading(fmt.Sprintf("Summary %s – %s (total %s)", s.From, s.Until, formatHours(s.TotalSeconds))) + "\n")
+	if len(s.Days) == 0 {
+		sb.WriteString(st.bullet + "No time logged.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("\n" + st.heading("By day") + "\n")
+	for _, day := range s.Days {
+		sb.WriteString(fmt.Sprintf("%s%s %s: %s\n", st.bullet, day.Weekday, day.Date, formatHours(day.TotalSeconds)))
+		for _, issue := range day.Issues {
+			issueLine(st.nested, issue)
+		}
+	}
+
+	sb.WriteString("\n" + st.heading("By epic") + "\n")
+	for _, epic := range s.Epics {
+		name := st.escape(epic.Summary)
+		if epic.Key != "" {
+			name = st.link(epic.Key) + ": " + name
+		}
+		sb.WriteString(fmt.Sprintf("%s%s (%s)\n", st.bullet, name, formatHours(epic.TotalSeconds)))
+		for _, issue := range epic.Issues {
+			issueLine(st.nested, issue)
+		}
+	}
+
+	return sb.String()
+}
+
+// renderSummaryHTML renders a summary as an HTML fragment
+func renderSummaryHTML(s *Summary) string {
+	var sb strings.Builder
+	link := func(key string) string {
+		if url := s.IssueURL(key); url != "" {
+			return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(key))
+		}
+		return "<strong>" + html.EscapeString(key) + "</strong>"
+	}
+	issueList := func(issues []IssueSummary) {
+		sb.WriteString("\n    <ul>\n")
+		for _, issue := range issues {
+			sb.WriteString(fmt.Sprintf("      <li>%s: %s (%s)</li>\n", link(issue.Key), html.EscapeString(issue.Summary), formatHours(issue.TotalSeconds)))
+		}
+		sb.WriteString("    </ul>\n  ")
+	}
+
+	sb.WriteString(fmt.Sprintf("<h3>Summary %s – %s (total %s)</h3>\n", html.EscapeString(s.From), html.EscapeString(s.Until), formatHours(s.TotalSeconds)))
+	if len(s.Days) == 0 {
+		sb.WriteString("<p>No time logged.</p>\n")
+		return sb.String()
+	}
+
+	sb.WriteString("<h4>By day</h4>\n<ul>\n")
+	for _, day := range s.Days {
+		sb.WriteString(fmt.Sprintf("  <li>%s %s: %s", day.Weekday, day.Date, formatHours(day.TotalSeconds)))
+		issueList(day.Issues)
+		sb.WriteString("</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	sb.WriteString("<h4>By epic</h4>\n<ul>\n")
+	for _, epic := range s.Epics {
+		name := html.EscapeString(epic.Summary)
+		if epic.Key != "" {
+			name = link(epic.Key) + ": " + name
+		}
+		sb.WriteString(fmt.Sprintf("  <li>%s (%s)", name, formatHours(epic.TotalSeconds)))
+		issueList(epic.Issues)
+		sb.WriteString("</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	return sb.String()
+}
@@ -0,0 +1,151 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func testSummaryWorklogs() []model.Worklog {
+	epicIssue := model.WorklogIssue{Key: "GRAP-1", Summary: "Login page", EpicKey: "GRAP-100", EpicSummary: "Auth epic"}
+	otherEpicIssue := model.WorklogIssue{Key: "GRAP-2", Summary: "Logout", EpicKey: "GRAP-100", EpicSummary: "Auth epic"}
+	looseIssue := model.WorklogIssue{Key: "GRAP-3", Summary: "Meetings"}
+
+	return []model.Worklog{
+		{Issue: epicIssue, StartDate: "2026-04-27", TimeSpentSeconds: 7200, Description: "form layout"},
+		{Issue: looseIssue, StartDate: "2026-04-27", TimeSpentSeconds: 1800, Description: "Working on issue GRAP-3"},
+		{Issue: epicIssue, StartDate: "2026-04-28", TimeSpentSeconds: 3600, Description: "form layout"},
+		{Issue: otherEpicIssue, StartDate: "2026-04-28", TimeSpentSeconds: 5400},
+		// Outside the range, must be ignored
+		{Issue: looseIssue, StartDate: "2026-05-04", TimeSpentSeconds: 3600},
+	}
+}
+
+func TestNewSummaryGroupsByDayIssueAndEpic(t *testing.T) {
+	s := NewSummary(testSummaryWorklogs(), "2026-04-27", "2026-05-03")
+
+	if s.TotalSeconds != 18000 {
+		t.Errorf("TotalSeconds = %d, want 18000", s.TotalSeconds)
+	}
+
+	if len(s.Days) != 2 || s.Days[0].Date != "2026-04-27" || s.Days[0].Weekday != "Monday" {
+		t.Fatalf("unexpected days: %+v", s.Days)
+	}
+	if s.Days[1].TotalSeconds != 9000 {
+		t.Errorf("second day total = %d, want 9000", s.Days[1].TotalSeconds)
+	}
+
+	if len(s.Issues) != 3 || s.Issues[0].Key != "GRAP-1" || s.Issues[0].TotalSeconds != 10800 {
+		t.Fatalf("expected GRAP-1 first with 3h, got %+v", s.Issues)
+	}
+	if len(s.Issues[0].Notes) != 1 {
+		t.Errorf("repeated notes should be deduplicated, got %v", s.Issues[0].Notes)
+	}
+
+	if len(s.Epics) != 2 {
+		t.Fatalf("expected 2 epic groups, got %+v", s.Epics)
+	}
+	if s.Epics[0].Key != "GRAP-100" || s.Epics[0].TotalSeconds != 16200 || len(s.Epics[0].Issues) != 2 {
+		t.Errorf("unexpected epic group: %+v", s.Epics[0])
+	}
+	if s.Epics[1].Key != "" || s.Epics[1].Summary != noEpicLabel {
+		t.Errorf("issues without an epic should be grouped last, got %+v", s.Epics[1])
+	}
+}
+
+func TestNewSummaryPutsSubtasksUnderTheirEpic(t *testing.T) {
+	// GRAP-5 is a sub-task of the story GRAP-4, which belongs to the epic GRAP-100
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/field":
+			fmt.Fprint(w, `[]`)
+		case "/rest/api/2/search":
+			var body struct {
+				JQL string `json:"jql"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			switch body.JQL {
+			case "id in (10005)":
+				fmt.Fprint(w, `{"total":1,"issues":[{"id":"10005","key":"GRAP-5","fields":{"summary":"Write tests",
+					"issuetype":{"name":"Sub-task","subtask":true},"parent":{"id":"10004","key":"GRAP-4","fields":{"summary":"Login page"}}}}]}`)
+			case "id in (10004)":
+				fmt.Fprint(w, `{"total":1,"issues":[{"id":"10004","key":"GRAP-4","fields":{"summary":"Login page",
+					"issuetype":{"name":"Story"},"parent":{"id":"10100","key":"GRAP-100","fields":{"summary":"Auth epic"}}}}]}`)
+			default:
+				t.Errorf("unexpected JQL %q", body.JQL)
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tempoClient := api.NewTempoClient("token", api.NewServerJiraClient(server.URL, "pat"))
+	worklogs, err := tempoClient.EnrichWorklogsWithIssueDetails([]model.Worklog{
+		{Issue: model.WorklogIssue{ID: 10005}, StartDate: "2026-04-27", TimeSpentSeconds: 3600},
+	})
+	if err != nil {
+		t.Fatalf("EnrichWorklogsWithIssueDetails() error = %v", err)
+	}
+
+	s := NewSummary(worklogs, "2026-04-27", "2026-05-03")
+	if len(s.Epics) != 1 || s.Epics[0].Key != "GRAP-100" || s.Epics[0].Summary != "Auth epic" {
+		t.Fatalf("expected the sub-task under its story's epic, got %+v", s.Epics)
+	}
+	if len(s.Epics[0].Issues) != 1 || s.Epics[0].Issues[0].Key != "GRAP-5" {
+		t.Errorf("unexpected epic issues: %+v", s.Epics[0].Issues)
+	}
+}
+
+func TestRenderSummaryFormats(t *testing.T) {
+	s := NewSummary(testSummaryWorklogs(), "2026-04-27", "2026-05-03")
+	s.BaseURL = "https://example.atlassian.net"
+
+	text, err := RenderSummary(s, FormatText)
+	if err != nil {
+		t.Fatalf("RenderSummary(text) error = %v", err)
+	}
+	if !strings.Contains(text, "- Monday 2026-04-27: 2.5h") {
+		t.Errorf("expected day total line, got:\n%s", text)
+	}
+	if !strings.Contains(text, "- GRAP-100: Auth epic (4.5h)") {
+		t.Errorf("expected epic total line, got:\n%s", text)
+	}
+
+	md, err := RenderSummary(s, FormatMarkdown)
+	if err != nil {
+		t.Fatalf("RenderSummary(markdown) error = %v", err)
+	}
+	if !strings.Contains(md, "[GRAP-1](https://example.atlassian.net/browse/GRAP-1)") {
+		t.Errorf("expected markdown link, got:\n%s", md)
+	}
+
+	out, err := RenderSummary(s, FormatJSON)
+	if err != nil {
+		t.Fatalf("RenderSummary(json) error = %v", err)
+	}
+	var decoded Summary
+	if err := json.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if decoded.TotalSeconds != s.TotalSeconds || len(decoded.Epics) != 2 {
+		t.Errorf("unexpected decoded summary: %+v", decoded)
+	}
+}
+
+func TestRenderSummaryEmptyRange(t *testing.T) {
+	out, err := RenderSummary(NewSummary(nil, "2026-04-27", "2026-05-03"), FormatText)
+	if err != nil {
+		t.Fatalf("RenderSummary() error = %v", err)
+	}
+	if !strings.Contains(out, "No time logged.") {
+		t.Errorf("expected empty message, got:\n%s", out)
+	}
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// DefaultTemplate is the built-in text template; it produces the classic standup output.
+// Copy it as a starting point for a custom template.
+//
+//go:embed templates/default.tmpl
+var DefaultTemplate string
+
+// templateFuncs are the helper functions available inside report templates
+var templateFuncs = template.FuncMap{
+	"icon":          getIcon,
+	"hours":         formatHours,
+	"blockerSuffix": blockerSuffix,
+	"join":          strings.Join,
+	"upper":         strings.ToUpper,
+	"lower":         strings.ToLower,
+}
+
+var defaultTemplate = template.Must(template.New("report").Funcs(templateFuncs).Parse(DefaultTemplate))
+
+// TemplateRenderer renders a report with a Go text/template
+type TemplateRenderer struct {
+	tmpl *template.Template
+}
+
+// NewTemplateRenderer parses a template from source text
+func NewTemplateRenderer(text string) (*TemplateRenderer, error) {
+	tmpl, err := template.New("report").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report template: %w", err)
+	}
+	return &TemplateRenderer{tmpl: tmpl}, nil
+}
+
+// LoadTemplateRenderer reads and parses a template file
+func LoadTemplateRenderer(path string) (*TemplateRenderer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report template: %w", err)
+	}
+	return NewTemplateRenderer(string(data))
+}
+
+// Render implements Renderer
+func (t *TemplateRenderer) Render(r *Report) (string, error) {
+	var sb strings.Builder
+	if err := t.tmpl.Execute(&sb, r); err != nil {
+		return "", fmt.Errorf("failed to execute report template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// NewRendererWithTemplate returns the renderer for a format, using the template file
+// at templatePath for the text format when one is configured
+func NewRendererWithTemplate(format Format, templatePath string) (Renderer, error) {
+	if format == FormatText && templatePath != "" {
+		return LoadTemplateRenderer(templatePath)
+	}
+	return NewRenderer(format)
+}
+
+// executeDefault runs a named section of the default template
+func executeDefault(name string, r *Report) (string, error) {
+	var sb strings.Builder
+	if err := defaultTemplate.ExecuteTemplate(&sb, name, r); err != nil {
+		return "", fmt.Errorf("failed to execute report template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// formatHours formats seconds as hours, e.g. 5400 -> "1.5h"
+func formatHours(seconds int) string {
+	return strings.TrimSuffix(strings.TrimSuffix(fmt.Sprintf("%.2f", float64(seconds)/3600.0), "0"), ".0") + "h"
+}
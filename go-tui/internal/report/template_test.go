@@ -0,0 +1,76 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestTemplateRendererCustomTemplate(t *testing.T) {
+	prevTasks := []model.Worklog{
+		{Issue: model.WorklogIssue{Key: "GRAP-1", Summary: "One"}, TimeSpentSeconds: 5400},
+		{Issue: model.WorklogIssue{Key: "GRAP-1", Summary: "One"}, TimeSpentSeconds: 1800},
+	}
+	r := NewReport(prevTasks, nil, time.Now().AddDate(0, 0, -1))
+
+	renderer, err := NewTemplateRenderer(`Standup ({{.PreviousLabel}}, {{hours .TotalSeconds}}):
+{{range .Yesterday}}- {{upper .Key}} {{hours .TimeSpentSeconds}}
+{{end}}`)
+	if err != nil {
+		t.Fatalf("NewTemplateRenderer() error = %v", err)
+	}
+
+	got, err := renderer.Render(r)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := "Standup (Yesterday, 2h):\n- GRAP-1 2h\n"
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestTemplateRendererInvalidTemplate(t *testing.T) {
+	if _, err := NewTemplateRenderer("{{.Missing"); err == nil {
+		t.Error("NewTemplateRenderer() error = nil, want parse error")
+	}
+}
+
+func TestNewRendererWithTemplateLoadsFileForTextOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte("custom {{len .Today}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReport(nil, []model.Issue{{Key: "GRAP-2"}}, time.Time{})
+
+	text, err := NewRendererWithTemplate(FormatText, path)
+	if err != nil {
+		t.Fatalf("NewRendererWithTemplate(text) error = %v", err)
+	}
+	if out, _ := text.Render(r); out != "custom 1" {
+		t.Errorf("text render = %q, want %q", out, "custom 1")
+	}
+
+	md, err := NewRendererWithTemplate(FormatMarkdown, path)
+	if err != nil {
+		t.Fatalf("NewRendererWithTemplate(markdown) error = %v", err)
+	}
+	if out, _ := md.Render(r); strings.Contains(out, "custom") {
+		t.Error("template should only apply to the text format")
+	}
+}
+
+func TestFormatHours(t *testing.T) {
+	tests := map[int]string{3600: "1h", 5400: "1.5h", 900: "0.25h", 0: "0h"}
+	for seconds, want := range tests {
+		if got := formatHours(seconds); got != want {
+			t.Errorf("formatHours(%d) = %q, want %q", seconds, got, want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package timer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Timer is a running timer bound to a Jira issue
+type Timer struct {
+	IssueKey     string    `json:"issueKey"`
+	IssueID      string    `json:"issueId"`
+	IssueSummary string    `json:"issueSummary,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	StartedAt    time.Time `json:"startedAt"`
+}
+
+// Elapsed returns how long the timer has been running at now
+func (t *Timer) Elapsed(now time.Time) time.Duration {
+	if now.Before(t.StartedAt) {
+		return 0
+	}
+	return now.Sub(t.StartedAt)
+}
+
+// StartDate returns the worklog date for the timer (the local day it was started)
+func (t *Timer) StartDate() string {
+	return t.StartedAt.Local().Format("2006-01-02")
+}
+
+// Rounding modes for turning tracked time into a worklog
+const (
+	RoundUp      = "up"
+	RoundDown    = "down"
+	RoundNearest = "nearest"
+)
+
+// Rounding describes how tracked time is rounded before it is logged
+type Rounding struct {
+	// Minutes is the rounding increment (e.g. 15 rounds to quarter hours)
+	Minutes int `json:"minutes,omitempty"`
+	// Mode is "up", "down" or "nearest"
+	Mode string `json:"mode,omitempty"`
+}
+
+// DefaultRounding rounds up to the next 15 minutes
+func DefaultRounding() Rounding {
+	return Rounding{Minutes: 15, Mode: RoundUp}
+}
+
+// WithDefaults fills unset fields from DefaultRounding
+func (r Rounding) WithDefaults() Rounding {
+	defaults := DefaultRounding()
+	if r.Minutes <= 0 {
+		r.Minutes = defaults.Minutes
+	}
+	if r.Mode == "" {
+		r.Mode = defaults.Mode
+	}
+	return r
+}
+
+// Validate checks the rounding mode
+func (r Rounding) Validate() error {
+	switch r.Mode {
+	case "", RoundUp, RoundDown, RoundNearest:
+		return nil
+	default:
+		return fmt.Errorf("invalid rounding mode %q (use up, down or nearest)", r.Mode)
+	}
+}
+
+// Apply rounds elapsed time to whole seconds of worklog time. The result is
+// never less than one increment, since Tempo rejects empty worklogs.
+func (r Rounding) Apply(elapsed time.Duration) int {
+	r = r.WithDefaults()
+	increment := time.Duration(r.Minutes) * time.Minute
+
+	var rounded time.Duration
+	switch r.Mode {
+	case RoundDown:
+		rounded = elapsed.Truncate(increment)
+	case RoundNearest:
+		rounded = elapsed.Round(increment)
+	default:
+		rounded = elapsed.Truncate(increment)
+		if rounded < elapsed {
+			rounded += increment
+		}
+	}
+
+	if rounded < increment {
+		rounded = increment
+	}
+	return int(rounded.Seconds())
+}
+
+// Store persists the running timer to disk so it survives restarts
+type Store struct {
+	path string
+}
+
+// NewStore creates a store at ~/.jira-daily-report-timer.json
+func NewStore() (*Store, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return NewStoreAt(filepath.Join(homeDir, ".jira-daily-report-timer.json")), nil
+}
+
+// NewStoreAt creates a store backed by the given file
+func NewStoreAt(path string) *Store {
+	return &Store{path: path}
+}
+
+// Load returns the running timer, or nil if none is running
+func (s *Store) Load() (*Timer, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read timer: %w", err)
+	}
+
+	var t Timer
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse timer: %w", err)
+	}
+	return &t, nil
+}
+
+// Save persists the running timer
+func (s *Store) Save(t *Timer) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal timer: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write timer: %w", err)
+	}
+	return nil
+}
+
+// Clear removes the running timer
+func (s *Store) Clear() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to clear timer: %w", err)
+	}
+	return nil
+}
+
+// FormatElapsed formats a running duration as "1:05:09"
+func FormatElapsed(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+	return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+}
@@ -0,0 +1,64 @@
+package timer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRoundingApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding Rounding
+		elapsed  time.Duration
+		want     int
+	}{
+		{name: "default rounds up", rounding: Rounding{}, elapsed: 62 * time.Minute, want: 75 * 60},
+		{name: "exact increment unchanged", rounding: Rounding{Minutes: 15, Mode: RoundUp}, elapsed: 30 * time.Minute, want: 30 * 60},
+		{name: "down", rounding: Rounding{Minutes: 15, Mode: RoundDown}, elapsed: 44 * time.Minute, want: 30 * 60},
+		{name: "nearest", rounding: Rounding{Minutes: 30, Mode: RoundNearest}, elapsed: 50 * time.Minute, want: 60 * 60},
+		{name: "minimum one increment", rounding: Rounding{Minutes: 10, Mode: RoundDown}, elapsed: 3 * time.Minute, want: 10 * 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rounding.Apply(tt.elapsed); got != tt.want {
+				t.Errorf("Apply(%v) = %d, want %d", tt.elapsed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	store := NewStoreAt(filepath.Join(t.TempDir(), "timer.json"))
+
+	if got, err := store.Load(); err != nil || got != nil {
+		t.Fatalf("Load() on empty store = %v, %v; want nil, nil", got, err)
+	}
+
+	started := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	if err := store.Save(&Timer{IssueKey: "GRAP-1", IssueID: "1001", StartedAt: started}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got.IssueKey != "GRAP-1" || !got.StartedAt.Equal(started) {
+		t.Errorf("Load() = %+v", got)
+	}
+
+	if err := store.Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if got, _ := store.Load(); got != nil {
+		t.Errorf("expected no timer after Clear(), got %+v", got)
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	if got := FormatElapsed(time.Hour + 5*time.Minute + 9*time.Second); got != "1:05:09" {
+		t.Errorf("FormatElapsed() = %q", got)
+	}
+}
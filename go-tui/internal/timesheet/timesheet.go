@@ -0,0 +1,131 @@
+package timesheet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// Settings describes how much time must be logged per workday
+type Settings struct {
+	// RequiredHours is the time expected on each weekday (default 8)
+	RequiredHours float64 `json:"requiredHours,omitempty"`
+	// Holidays are YYYY-MM-DD dates with nothing required
+	Holidays []string `json:"holidays,omitempty"`
+	// UseTempoSchedule reads required time, weekends and holidays from Tempo instead
+	UseTempoSchedule bool `json:"useTempoSchedule,omitempty"`
+}
+
+// DefaultRequiredHours is the required time per weekday when none is configured
+const DefaultRequiredHours = 8
+
+// WithDefaults fills unset fields with their defaults
+func (s Settings) WithDefaults() Settings {
+	if s.RequiredHours <= 0 {
+		s.RequiredHours = DefaultRequiredHours
+	}
+	return s
+}
+
+// ScheduleFetcher loads a work schedule from Tempo
+type ScheduleFetcher interface {
+	FetchUserScheduleContext(ctx context.Context, from, to string) ([]model.ScheduleDay, error)
+}
+
+// Schedule returns the required time for every day from..to (YYYY-MM-DD, inclusive),
+// from Tempo when UseTempoSchedule is set, otherwise from the configured hours
+func (s Settings) Schedule(ctx context.Context, fetcher ScheduleFetcher, from, to string) ([]model.ScheduleDay, error) {
+	if s.UseTempoSchedule && fetcher != nil {
+		days, err := fetcher.FetchUserScheduleContext(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return days, nil
+	}
+	return s.ConfigSchedule(from, to)
+}
+
+// ConfigSchedule builds a schedule of RequiredHours on weekdays, skipping weekends and holidays
+func (s Settings) ConfigSchedule(from, to string) ([]model.ScheduleDay, error) {
+	s = s.WithDefaults()
+
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q: %w", from, err)
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %q: %w", to, err)
+	}
+
+	holidays := make(map[string]bool, len(s.Holidays))
+	for _, h := range s.Holidays {
+		holidays[h] = true
+	}
+
+	var days []model.ScheduleDay
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		date := d.Format("2006-01-02")
+		day := model.ScheduleDay{Date: date, Type: model.ScheduleWorkingDay}
+		switch {
+		case d.Weekday() == time.Saturday || d.Weekday() == time.Sunday:
+			day.Type = model.ScheduleNonWorkingDay
+		case holidays[date]:
+			day.Type = model.ScheduleHoliday
+		default:
+			day.RequiredSeconds = int(s.RequiredHours * 3600)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// Gap is a day with less time logged than required
+type Gap struct {
+	Date            string
+	RequiredSeconds int
+	LoggedSeconds   int
+}
+
+// MissingSeconds returns the time still to be logged for the day
+func (g Gap) MissingSeconds() int {
+	return g.RequiredSeconds - g.LoggedSeconds
+}
+
+// Check compares logged time per date group against the schedule and returns the
+// under-logged days, oldest first
+func Check(schedule []model.ScheduleDay, groups []model.DateGroup) []Gap {
+	logged := make(map[string]int, len(groups))
+	for _, group := range groups {
+		logged[group.Date] += group.TotalSeconds
+	}
+
+	var gaps []Gap
+	for _, day := range schedule {
+		if day.RequiredSeconds <= 0 || logged[day.Date] >= day.RequiredSeconds {
+			continue
+		}
+		gaps = append(gaps, Gap{
+			Date:            day.Date,
+			RequiredSeconds: day.RequiredSeconds,
+			LoggedSeconds:   logged[day.Date],
+		})
+	}
+
+	sort.Slice(gaps, func(i, j int) bool {
+		return gaps[i].Date < gaps[j].Date
+	})
+	return gaps
+}
+
+// TotalMissing sums the missing time over all gaps
+func TotalMissing(gaps []Gap) int {
+	total := 0
+	for _, g := range gaps {
+		total += g.MissingSeconds()
+	}
+	return total
+}
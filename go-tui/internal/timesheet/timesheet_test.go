@@ -0,0 +1,95 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestConfigScheduleSkipsWeekendsAndHolidays(t *testing.T) {
+	settings := Settings{RequiredHours: 7.5, Holidays: []string{"2026-04-03"}}
+
+	// Thursday 2026-04-02 .. Monday 2026-04-06
+	days, err := settings.ConfigSchedule("2026-04-02", "2026-04-06")
+	if err != nil {
+		t.Fatalf("ConfigSchedule() error = %v", err)
+	}
+
+	want := map[string]int{
+		"2026-04-02": 27000,
+		"2026-04-03": 0, // holiday
+		"2026-04-04": 0, // Saturday
+		"2026-04-05": 0, // Sunday
+		"2026-04-06": 27000,
+	}
+	if len(days) != len(want) {
+		t.Fatalf("expected %d days, got %d", len(want), len(days))
+	}
+	for _, day := range days {
+		if day.RequiredSeconds != want[day.Date] {
+			t.Errorf("%s requires %d seconds, want %d", day.Date, day.RequiredSeconds, want[day.Date])
+		}
+	}
+}
+
+func TestCheckReportsUnderLoggedDays(t *testing.T) {
+	schedule := []model.ScheduleDay{
+		{Date: "2026-04-01", RequiredSeconds: 28800},
+		{Date: "2026-04-02", RequiredSeconds: 28800},
+		{Date: "2026-04-03", RequiredSeconds: 28800},
+		{Date: "2026-04-04", RequiredSeconds: 0},
+	}
+	groups := []model.DateGroup{
+		{Date: "2026-04-02", TotalSeconds: 28800},
+		{Date: "2026-04-01", TotalSeconds: 18000},
+		{Date: "2026-04-04", TotalSeconds: 3600},
+	}
+
+	gaps := Check(schedule, groups)
+
+	if len(gaps) != 2 {
+		t.Fatalf("expected 2 gaps, got %+v", gaps)
+	}
+	if gaps[0].Date != "2026-04-01" || gaps[0].MissingSeconds() != 10800 {
+		t.Errorf("unexpected first gap %+v", gaps[0])
+	}
+	if gaps[1].Date != "2026-04-03" || gaps[1].LoggedSeconds != 0 {
+		t.Errorf("a day without worklogs should be a full gap, got %+v", gaps[1])
+	}
+	if got := TotalMissing(gaps); got != 10800+28800 {
+		t.Errorf("TotalMissing() = %d", got)
+	}
+}
+
+type fakeFetcher struct {
+	days []model.ScheduleDay
+	err  error
+}
+
+func (f fakeFetcher) FetchUserScheduleContext(ctx context.Context, from, to string) ([]model.ScheduleDay, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.days, f.err
+}
+
+func TestScheduleUsesTempoWhenEnabled(t *testing.T) {
+	tempoDays := []model.ScheduleDay{{Date: "2026-04-01", RequiredSeconds: 21600, Type: model.ScheduleWorkingDay}}
+
+	days, err := Settings{UseTempoSchedule: true}.Schedule(context.Background(), fakeFetcher{days: tempoDays}, "2026-04-01", "2026-04-01")
+	if err != nil || len(days) != 1 || days[0].RequiredSeconds != 21600 {
+		t.Errorf("Schedule() = %+v, %v; want the Tempo schedule", days, err)
+	}
+
+	if _, err := (Settings{UseTempoSchedule: true}).Schedule(context.Background(), fakeFetcher{err: errors.New("boom")}, "2026-04-01", "2026-04-01"); err == nil {
+		t.Error("expected the Tempo error to be returned")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (Settings{UseTempoSchedule: true}).Schedule(ctx, fakeFetcher{days: tempoDays}, "2026-04-01", "2026-04-01"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Schedule() error = %v, want the cancellation", err)
+	}
+}
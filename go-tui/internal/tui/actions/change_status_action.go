@@ -3,29 +3,31 @@ package actions
 import (
 	"errors"
 	"fmt"
-	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
 	"github.com/yourusername/jira-daily-report/internal/tui/state"
 )
 
 // ChangeStatusAction changes the status of a Jira ticket
 type ChangeStatusAction struct {
-	taskKey       string
-	currentStatus string
-	targetStatus  string
-	transitionID  string
-	sourcePanel   state.PanelType
-	targetPanel   state.PanelType
-	originalTask  *model.Issue
+	taskKey        string
+	currentStatus  string
+	targetStatus   string
+	targetCategory *model.StatusCategory
+	transitionID   string
+	sourcePanel    state.PanelType
+	targetPanel    state.PanelType
+	originalTask   *model.Issue
 }
 
 // NewChangeStatusAction creates a new ChangeStatusAction
-func NewChangeStatusAction(targetStatus string, transitionID string) *ChangeStatusAction {
+func NewChangeStatusAction(targetStatus model.Status, transitionID string) *ChangeStatusAction {
 	return &ChangeStatusAction{
-		targetStatus: targetStatus,
-		transitionID: transitionID,
+		targetStatus:   targetStatus.Name,
+		targetCategory: targetStatus.StatusCategory,
+		transitionID:   transitionID,
 	}
 }
 
@@ -49,7 +51,11 @@ func (a *ChangeStatusAction) Validate(ctx ActionContext) error {
 	a.originalTask = &taskCopy
 
 	// Determine target panel based on status
-	a.targetPanel = a.determineTargetPanel(a.targetStatus)
+	statuses := model.DefaultStatusMapping()
+	if ctx.Config != nil {
+		statuses = ctx.Config.GetStatusMapping()
+	}
+	a.targetPanel = a.determineTargetPanel(statuses)
 
 	// Validate transition ID is provided
 	if a.transitionID == "" {
@@ -65,6 +71,10 @@ func (a *ChangeStatusAction) Execute(ctx ActionContext) tea.Cmd {
 		// Call Jira API to perform transition
 		err := ctx.JiraClient.TransitionIssue(a.taskKey, a.transitionID)
 		if err != nil {
+			entry := outbox.NewTransitionEntry(a.taskKey, a.transitionID, a.targetStatus)
+			if msg, ok := queueIfOffline(ctx, a, entry, err); ok {
+				return msg
+			}
 			return ActionFailedMsg{
 				ActionName: a.Name(),
 				Error:      fmt.Errorf("failed to change status: %w", err),
@@ -120,29 +130,20 @@ func (a *ChangeStatusAction) GetRefreshStrategy() state.RefreshStrategy {
 	return state.RefreshPolling
 }
 
-// determineTargetPanel determines which panel a task should be in based on its status
-func (a *ChangeStatusAction) determineTargetPanel(status string) state.PanelType {
-	statusLower := strings.ToLower(status)
+// determineTargetPanel determines which panel a task should be in based on its target status
+func (a *ChangeStatusAction) determineTargetPanel(statuses model.StatusMapping) state.PanelType {
+	group, ok := statuses.Group(model.Status{Name: a.targetStatus, StatusCategory: a.targetCategory})
+	if !ok {
+		// Keep in current panel if unknown status
+		return a.sourcePanel
+	}
 
-	// Map statuses to panels based on typical Jira workflows
-	switch {
-	case strings.Contains(statusLower, "open"),
-		strings.Contains(statusLower, "to do"),
-		strings.Contains(statusLower, "selected for development"):
+	switch group {
+	case model.StatusGroupTodo:
 		return state.PanelTodo
-
-	case strings.Contains(statusLower, "in progress"),
-		strings.Contains(statusLower, "development"):
+	case model.StatusGroupInProgress:
 		return state.PanelReport
-
-	case strings.Contains(statusLower, "review"),
-		strings.Contains(statusLower, "testing"),
-		strings.Contains(statusLower, "qa"),
-		strings.Contains(statusLower, "ready for"):
-		return state.PanelProcessing
-
 	default:
-		// Keep in current panel if unknown status
-		return a.sourcePanel
+		return state.PanelProcessing
 	}
 }
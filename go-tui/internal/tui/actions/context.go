@@ -4,6 +4,7 @@ import (
 	"github.com/yourusername/jira-daily-report/internal/api"
 	"github.com/yourusername/jira-daily-report/internal/config"
 	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
 	"github.com/yourusername/jira-daily-report/internal/tui/state"
 )
 
@@ -36,6 +37,9 @@ type ActionContext struct {
 	// UserAccountID is the Jira account ID of the current user
 	UserAccountID string
 
+	// Outbox queues writes that fail while offline (nil disables queueing)
+	Outbox *outbox.Store
+
 	// Additional data that might be passed from modals or other sources
 	ExtraData map[string]interface{}
 }
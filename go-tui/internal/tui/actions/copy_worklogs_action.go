@@ -0,0 +1,135 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+// WorklogCopy is one worklog to recreate on the target date
+type WorklogCopy struct {
+	Worklog model.Worklog // The source worklog
+	Seconds int           // Time to log, possibly changed from the source
+}
+
+// CopyWorklogsAction recreates worklogs from one day on another date via Tempo
+type CopyWorklogsAction struct {
+	entries   []WorklogCopy
+	date      string
+	accountID string
+}
+
+// NewCopyWorklogsAction creates a new CopyWorklogsAction
+func NewCopyWorklogsAction(entries []WorklogCopy, date string) *CopyWorklogsAction {
+	return &CopyWorklogsAction{
+		entries: entries,
+		date:    date,
+	}
+}
+
+// Name returns the action name
+func (a *CopyWorklogsAction) Name() string {
+	return "Copy Worklogs"
+}
+
+// Validate checks if the action can be executed
+func (a *CopyWorklogsAction) Validate(ctx ActionContext) error {
+	if len(a.entries) == 0 {
+		return errors.New("no worklogs selected")
+	}
+
+	parsedDate, err := dateutil.ParseWorklogDate(a.date)
+	if err != nil {
+		return fmt.Errorf("invalid date: %w", err)
+	}
+	a.date = parsedDate
+
+	a.accountID = ctx.UserAccountID
+	if a.accountID == "" {
+		return errors.New("user account ID not available")
+	}
+
+	for _, entry := range a.entries {
+		if entry.Worklog.Issue.ID == 0 || entry.Seconds <= 0 {
+			return fmt.Errorf("invalid worklog for %s", entry.Worklog.Issue.Key)
+		}
+	}
+
+	return nil
+}
+
+// Execute creates the worklogs on the target date, continuing past individual failures
+func (a *CopyWorklogsAction) Execute(ctx ActionContext) tea.Cmd {
+	return func() tea.Msg {
+		var failed []string
+		var lastErr error
+		for _, entry := range a.entries {
+			_, err := ctx.TempoClient.CreateWorklogRequest(model.NewWorklogCopy(entry.Worklog, entry.Seconds, a.date, a.accountID))
+			if err != nil {
+				failed = append(failed, entry.Worklog.Issue.Key)
+				lastErr = err
+			}
+		}
+
+		copied := len(a.entries) - len(failed)
+		if copied == 0 {
+			return ActionFailedMsg{
+				ActionName: a.Name(),
+				Error:      fmt.Errorf("failed to copy worklogs: %w", lastErr),
+				Retryable:  true,
+			}
+		}
+
+		message := fmt.Sprintf("Copied %d worklogs to %s", copied, a.date)
+		if len(failed) > 0 {
+			message = fmt.Sprintf("Copied %d/%d worklogs to %s (failed: %s)", copied, len(a.entries), a.date, strings.Join(failed, ", "))
+		}
+
+		return ActionCompletedMsg{
+			ActionName: a.Name(),
+			Action:     a,
+			Result: map[string]interface{}{
+				"copied":  copied,
+				"failed":  failed,
+				"message": message,
+			},
+		}
+	}
+}
+
+// OptimisticUpdate shows progress while the worklogs are created
+func (a *CopyWorklogsAction) OptimisticUpdate(s *state.State) *state.State {
+	s.StatusMessage = fmt.Sprintf("Copying %d worklogs to %s...", len(a.entries), a.date)
+	return s
+}
+
+// OnSuccess reports how many worklogs were copied
+func (a *CopyWorklogsAction) OnSuccess(s *state.State, result interface{}) *state.State {
+	s.StateSnapshot = nil
+
+	if resultMap, ok := result.(map[string]interface{}); ok {
+		if msg, ok := resultMap["message"].(string); ok {
+			s.StatusMessage = msg
+		}
+	}
+
+	s.CurrentAction = nil
+	return s
+}
+
+// OnError handles failure
+func (a *CopyWorklogsAction) OnError(s *state.State, err error) *state.State {
+	s.StatusMessage = fmt.Sprintf("Failed to copy worklogs: %v", err)
+	s.CurrentAction = nil
+	return s
+}
+
+// GetRefreshStrategy returns the refresh strategy for this action
+func (a *CopyWorklogsAction) GetRefreshStrategy() state.RefreshStrategy {
+	return state.RefreshPolling
+}
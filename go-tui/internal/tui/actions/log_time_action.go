@@ -6,9 +6,12 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
 	"github.com/yourusername/jira-daily-report/internal/tui/state"
 )
 
@@ -21,6 +24,14 @@ type LogTimeAction struct {
 	description string
 	date        string
 	accountID   string
+	extras      WorklogExtras
+}
+
+// WorklogExtras are the optional Tempo fields of a new worklog
+type WorklogExtras struct {
+	StartTime       string // HH:MM:SS
+	BillableSeconds *int   // nil bills the time spent
+	Attributes      []model.WorkAttributeValue
 }
 
 // NewLogTimeAction creates a new LogTimeAction
@@ -32,6 +43,12 @@ func NewLogTimeAction(timeValue, description, date string) *LogTimeAction {
 	}
 }
 
+// WithExtras sets the start time, billable time and work attributes of the worklog
+func (a *LogTimeAction) WithExtras(extras WorklogExtras) *LogTimeAction {
+	a.extras = extras
+	return a
+}
+
 // Name returns the action name
 func (a *LogTimeAction) Name() string {
 	return "Log Time"
@@ -83,14 +100,24 @@ func (a *LogTimeAction) Execute(ctx ActionContext) tea.Cmd {
 		}
 
 		// Call Tempo API
-		worklog, err := ctx.TempoClient.CreateWorklog(
-			issueID,
-			a.timeSeconds,
-			a.date,
-			a.description,
-			a.accountID,
-		)
+		request := model.WorklogRequest{
+			IssueID:          issueID,
+			TimeSpentSeconds: a.timeSeconds,
+			StartDate:        a.date,
+			StartTime:        a.extras.StartTime,
+			Description:      a.description,
+			AuthorAccountID:  a.accountID,
+			BillableSeconds:  a.extras.BillableSeconds,
+			Attributes:       a.extras.Attributes,
+		}
+		sent := time.Now()
+		worklog, err := ctx.TempoClient.CreateWorklogRequest(request)
 		if err != nil {
+			entry := outbox.NewWorklogEntry(a.taskKey, request)
+			entry.CreatedAt = sent
+			if msg, ok := queueIfOffline(ctx, a, entry, err); ok {
+				return msg
+			}
 			return ActionFailedMsg{
 				ActionName: a.Name(),
 				Error:      fmt.Errorf("failed to log time: %w", err),
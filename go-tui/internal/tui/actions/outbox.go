@@ -0,0 +1,42 @@
+package actions
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+)
+
+// queueIfOffline queues a write that failed because the network is down. It returns the
+// completed message to send instead of the failure, or false if the write was not queued.
+func queueIfOffline(ctx ActionContext, action Action, entry outbox.Entry, err error) (tea.Msg, bool) {
+	if ctx.Outbox == nil || !outbox.IsOffline(err) {
+		return nil, false
+	}
+
+	entry.SetError(err)
+	queued, queueErr := ctx.Outbox.Add(entry)
+	if queueErr != nil {
+		return nil, false
+	}
+
+	return ActionCompletedMsg{
+		ActionName: action.Name(),
+		Action:     action,
+		Result: map[string]interface{}{
+			"queued":  true,
+			"entry":   queued,
+			"message": fmt.Sprintf("📥 Offline: queued %s", queued.Summary()),
+		},
+	}, true
+}
+
+// IsQueued reports whether an action result is a write queued in the outbox
+func IsQueued(result interface{}) bool {
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	queued, _ := resultMap["queued"].(bool)
+	return queued
+}
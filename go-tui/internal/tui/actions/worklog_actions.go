@@ -0,0 +1,214 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+// EditWorklogAction changes the time, date and description of an existing Tempo worklog
+type EditWorklogAction struct {
+	worklog     model.Worklog
+	timeValue   string
+	timeSeconds int
+	description string
+	date        string
+}
+
+// NewEditWorklogAction creates a new EditWorklogAction
+func NewEditWorklogAction(worklog model.Worklog, timeValue, description, date string) *EditWorklogAction {
+	return &EditWorklogAction{
+		worklog:     worklog,
+		timeValue:   timeValue,
+		description: description,
+		date:        date,
+	}
+}
+
+// Name returns the action name
+func (a *EditWorklogAction) Name() string {
+	return "Edit Worklog"
+}
+
+// Validate checks if the action can be executed
+func (a *EditWorklogAction) Validate(ctx ActionContext) error {
+	if a.worklog.TempoWorklogID == 0 {
+		return errors.New("no worklog selected")
+	}
+
+	seconds, err := parseTimeString(a.timeValue)
+	if err != nil {
+		return fmt.Errorf("invalid time format: %w", err)
+	}
+	a.timeSeconds = seconds
+
+	parsedDate, err := dateutil.ParseWorklogDate(a.date)
+	if err != nil {
+		return fmt.Errorf("invalid date: %w", err)
+	}
+	a.date = parsedDate
+
+	if a.worklog.Author.AccountID == "" {
+		a.worklog.Author.AccountID = ctx.UserAccountID
+	}
+	if a.worklog.Author.AccountID == "" {
+		return errors.New("user account ID not available")
+	}
+
+	return nil
+}
+
+// Execute updates the worklog via the Tempo API
+func (a *EditWorklogAction) Execute(ctx ActionContext) tea.Cmd {
+	return func() tea.Msg {
+		// Tempo replaces the whole worklog, so start from its current state, not the listed copy
+		existing, err := ctx.TempoClient.FetchWorklog(a.worklog.TempoWorklogID)
+		if err != nil {
+			return ActionFailedMsg{
+				ActionName: a.Name(),
+				Error:      fmt.Errorf("failed to fetch worklog: %w", err),
+				Retryable:  true,
+			}
+		}
+
+		update := model.NewWorklogUpdate(*existing)
+		update.SetTimeSpent(a.timeSeconds)
+		update.StartDate = a.date
+		update.Description = a.description
+		if update.AuthorAccountID == "" {
+			update.AuthorAccountID = a.worklog.Author.AccountID
+		}
+
+		_, err = ctx.TempoClient.UpdateWorklog(a.worklog.TempoWorklogID, update)
+		if err != nil {
+			return ActionFailedMsg{
+				ActionName: a.Name(),
+				Error:      fmt.Errorf("failed to update worklog: %w", err),
+				Retryable:  true,
+			}
+		}
+
+		return ActionCompletedMsg{
+			ActionName: a.Name(),
+			Action:     a,
+			Result: map[string]interface{}{
+				"worklogID": a.worklog.TempoWorklogID,
+				"message":   fmt.Sprintf("Updated worklog on %s to %s", a.worklog.Issue.Key, a.timeValue),
+			},
+		}
+	}
+}
+
+// OptimisticUpdate shows progress while the worklog is updated
+func (a *EditWorklogAction) OptimisticUpdate(s *state.State) *state.State {
+	s.StatusMessage = fmt.Sprintf("Updating worklog on %s...", a.worklog.Issue.Key)
+	return s
+}
+
+// OnSuccess reports the update; the polling refresh reloads the worklogs
+func (a *EditWorklogAction) OnSuccess(s *state.State, result interface{}) *state.State {
+	s.StateSnapshot = nil
+
+	if resultMap, ok := result.(map[string]interface{}); ok {
+		if msg, ok := resultMap["message"].(string); ok {
+			s.StatusMessage = msg
+		}
+	}
+
+	s.CurrentAction = nil
+	return s
+}
+
+// OnError handles failure
+func (a *EditWorklogAction) OnError(s *state.State, err error) *state.State {
+	s.StatusMessage = fmt.Sprintf("Failed to update worklog: %v", err)
+	s.CurrentAction = nil
+	return s
+}
+
+// GetRefreshStrategy returns the refresh strategy for this action
+func (a *EditWorklogAction) GetRefreshStrategy() state.RefreshStrategy {
+	return state.RefreshPolling
+}
+
+// DeleteWorklogAction deletes a Tempo worklog
+type DeleteWorklogAction struct {
+	worklog model.Worklog
+}
+
+// NewDeleteWorklogAction creates a new DeleteWorklogAction
+func NewDeleteWorklogAction(worklog model.Worklog) *DeleteWorklogAction {
+	return &DeleteWorklogAction{worklog: worklog}
+}
+
+// Name returns the action name
+func (a *DeleteWorklogAction) Name() string {
+	return "Delete Worklog"
+}
+
+// Validate checks if the action can be executed
+func (a *DeleteWorklogAction) Validate(ctx ActionContext) error {
+	if a.worklog.TempoWorklogID == 0 {
+		return errors.New("no worklog selected")
+	}
+	return nil
+}
+
+// Execute deletes the worklog via the Tempo API
+func (a *DeleteWorklogAction) Execute(ctx ActionContext) tea.Cmd {
+	return func() tea.Msg {
+		if err := ctx.TempoClient.DeleteWorklog(a.worklog.TempoWorklogID); err != nil {
+			return ActionFailedMsg{
+				ActionName: a.Name(),
+				Error:      fmt.Errorf("failed to delete worklog: %w", err),
+				Retryable:  true,
+			}
+		}
+
+		return ActionCompletedMsg{
+			ActionName: a.Name(),
+			Action:     a,
+			Result: map[string]interface{}{
+				"worklogID": a.worklog.TempoWorklogID,
+				"message":   fmt.Sprintf("Deleted worklog on %s (%s)", a.worklog.Issue.Key, a.worklog.StartDate),
+			},
+		}
+	}
+}
+
+// OptimisticUpdate shows progress while the worklog is deleted
+func (a *DeleteWorklogAction) OptimisticUpdate(s *state.State) *state.State {
+	s.StatusMessage = fmt.Sprintf("Deleting worklog on %s...", a.worklog.Issue.Key)
+	return s
+}
+
+// OnSuccess removes the deleted worklog from state
+func (a *DeleteWorklogAction) OnSuccess(s *state.State, result interface{}) *state.State {
+	s.StateSnapshot = nil
+	s.RemoveWorklog(a.worklog.TempoWorklogID)
+
+	if resultMap, ok := result.(map[string]interface{}); ok {
+		if msg, ok := resultMap["message"].(string); ok {
+			s.StatusMessage = msg
+		}
+	}
+
+	s.CurrentAction = nil
+	return s
+}
+
+// OnError handles failure
+func (a *DeleteWorklogAction) OnError(s *state.State, err error) *state.State {
+	s.StatusMessage = fmt.Sprintf("Failed to delete worklog: %v", err)
+	s.CurrentAction = nil
+	return s
+}
+
+// GetRefreshStrategy returns the refresh strategy for this action
+func (a *DeleteWorklogAction) GetRefreshStrategy() state.RefreshStrategy {
+	return state.RefreshPolling
+}
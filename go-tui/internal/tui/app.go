@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -10,10 +12,13 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/cache"
 	"github.com/yourusername/jira-daily-report/internal/config"
 	"github.com/yourusername/jira-daily-report/internal/jira"
 	"github.com/yourusername/jira-daily-report/internal/model"
-	"github.com/yourusername/jira-daily-report/internal/report"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+	"github.com/yourusername/jira-daily-report/internal/timesheet"
 	"github.com/yourusername/jira-daily-report/internal/tui/actions"
 	"github.com/yourusername/jira-daily-report/internal/tui/buddy"
 	"github.com/yourusername/jira-daily-report/internal/tui/refresh"
@@ -37,6 +42,19 @@ type Model struct {
 	copyOptionsModal   *CopyOptionsModal
 	reportPreviewModal *ReportPreviewModal
 	statusModal        *StatusDialogModel
+	confirmDialog      *ConfirmDialogModel
+	timesheetGrid      *TimesheetGridModal
+	copyWorklogsModal  *CopyWorklogsModal
+	timerStore         *timer.Store
+	runningTimer       *timer.Timer
+	timerStopPending   bool // A stopped timer's worklog is being logged
+	outboxStore        *outbox.Store
+	outboxEntries      []outbox.Entry // Writes queued while offline
+	cacheStore         *cache.Store
+	syncBase           *cache.Snapshot // Cached data shown until the first sync completes
+	syncedAt           time.Time       // When the data shown was last synced
+	loads              *loadCanceler   // Cancels superseded task and worklog loads
+	weekLoads          *loadCanceler   // Cancels superseded timesheet grid week loads
 	lastKey            string
 	spinner            spinner.Model
 	searchBar          SearchBar
@@ -49,24 +67,26 @@ type transitionsFetchedMsg struct {
 	status      string
 }
 
-// NewModel creates a new TUI model
-func NewModel(cfg *config.Manager) *Model {
-	// Prefer OAuth if available, fallback to Basic Auth
-	var jiraClient *api.JiraClient
-	if oauthToken := cfg.GetOAuthToken(); oauthToken != "" {
-		jiraClient = api.NewOAuthJiraClient(cfg.GetJiraServer(), oauthToken)
-	} else {
-		jiraClient = api.NewJiraClient(
-			cfg.GetJiraServer(),
-			cfg.GetUsername(),
-			cfg.GetApiToken(),
-		)
+// NewModel creates a new TUI model using the given API clients
+func NewModel(cfg *config.Manager, jiraClient *api.JiraClient, tempoClient *api.TempoClient) *Model {
+	// A timer started earlier (TUI or CLI) keeps running across restarts
+	var timerStore *timer.Store
+	var runningTimer *timer.Timer
+	if path, err := config.StateFile("timer"); err == nil {
+		timerStore = timer.NewStoreAt(path)
+		runningTimer, _ = timerStore.Load()
 	}
 
-	tempoClient := api.NewTempoClient(
-		cfg.GetTempoApiToken(),
-		jiraClient,
-	)
+	// Writes queued while offline are replayed once the network is back
+	var outboxStore *outbox.Store
+	var outboxEntries []outbox.Entry
+	if path, err := config.StateFile("outbox"); err == nil {
+		outboxStore = outbox.NewStoreAt(path)
+		outboxEntries, _ = outboxStore.List()
+	}
+
+	// The last synced data is shown instantly on startup, then refreshed
+	cacheStore, _ := cache.NewStore(cfg.GetProfile())
 
 	s := spinner.New()
 	s.Spinner = spinner.Dot
@@ -83,6 +103,13 @@ func NewModel(cfg *config.Manager) *Model {
 		spinner:        s,
 		searchBar:      NewSearchBar(80),
 		buddy:          buddy.NewBuddy(cfg.GetUsername()),
+		timerStore:     timerStore,
+		runningTimer:   runningTimer,
+		outboxStore:    outboxStore,
+		outboxEntries:  outboxEntries,
+		cacheStore:     cacheStore,
+		loads:          newLoadCanceler(),
+		weekLoads:      newLoadCanceler(),
 	}
 }
 
@@ -99,10 +126,12 @@ type delayedRefreshMsg struct{}
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
-		m.loadTasksCmd,
+		m.loadCacheCmd,
 		tea.EnterAltScreen,
 		m.spinner.Tick,
 		m.buddyTickCmd(),
+		m.timerTickCmd(),
+		m.outboxTickCmd(),
 	)
 }
 
@@ -112,13 +141,20 @@ type tasksLoadedMsg struct {
 	reportTasks     []model.Issue
 	todoTasks       []model.Issue
 	processingTasks []model.Issue
+	syncedAt        time.Time // When the fetch started
 }
 
-// worklogsLoadedMsg is sent when worklogs are loaded (background)
+// worklogsLoadedMsg is sent when all worklog pages have streamed in (background)
 type worklogsLoadedMsg struct {
-	worklogs   []model.Worklog
+	err error
+}
+
+// worklogsPageMsg is sent for each page while worklogs stream in (background)
+type worklogsPageMsg struct {
+	worklogs   []model.Worklog // All worklogs loaded so far
 	dateGroups []model.DateGroup
 	err        error
+	pages      chan worklogsPageMsg
 }
 
 type startPhase2Msg struct{}
@@ -132,9 +168,13 @@ func (m *Model) buddyTickCmd() tea.Cmd {
 }
 
 // loadTasksCmd fetches user and tasks (fast path - Phase 1)
-// OPTIMIZED: Now uses FetchAllTasks which combines 4 queries into 1 HTTP request
+// OPTIMIZED: Now uses FetchAllTasks which combines 4 queries into 1 HTTP request.
+// With a recent cached snapshot only the tasks changed since it was saved are fetched.
 func (m *Model) loadTasksCmd() tea.Msg {
 	username := m.config.GetUsername()
+	statuses := m.config.GetStatusMapping()
+	syncedAt := time.Now()
+	ctx := m.loads.next() // A refresh cancels the load it supersedes
 
 	// Fetch user and all tasks in parallel
 	type result struct {
@@ -144,22 +184,47 @@ func (m *Model) loadTasksCmd() tea.Msg {
 	userChan := make(chan result, 1)
 
 	go func() {
-		user, err := m.jiraClient.FetchCurrentUser()
+		user, err := m.jiraClient.FetchCurrentUserContext(ctx)
 		userChan <- result{user, err}
 	}()
 
-	// Fetch all task categories in a single HTTP request (was 4 parallel requests)
-	inProgress, todo, underReview, testing, err := m.jiraClient.FetchAllTasks(username)
+	var inProgress, todo, underReview, testing []model.Issue
+	var err error
+	if base := m.syncBase; base.Incremental() {
+		var updated []model.Issue
+		var removedKeys []string
+		updated, removedKeys, err = m.jiraClient.FetchTaskChangesContext(ctx, username, statuses, base.SyncedAt)
+		if err == nil {
+			issues := cache.MergeIssues(base.Issues, updated, removedKeys)
+			inProgress, todo, underReview, testing = api.CategorizeTasks(issues, statuses)
+		}
+	} else {
+		// Fetch all task categories in a single HTTP request (was 4 parallel requests)
+		inProgress, todo, underReview, testing, err = m.jiraClient.FetchAllTasksContext(ctx, username, statuses)
+	}
+	if ctx.Err() != nil {
+		return nil // Superseded by a newer load
+	}
 	if err != nil {
-		return errMsg{err}
+		return m.syncError(err)
 	}
 
 	// Wait for user fetch to complete
 	userResult := <-userChan
+	if ctx.Err() != nil {
+		return nil
+	}
 	if userResult.err != nil {
-		return errMsg{userResult.err}
+		return m.syncError(userResult.err)
 	}
 
+	msg := newTasksLoadedMsg(userResult.user, inProgress, todo, underReview, testing)
+	msg.syncedAt = syncedAt
+	return msg
+}
+
+// newTasksLoadedMsg sorts the task groups into the panels
+func newTasksLoadedMsg(user *model.User, inProgress, todo, underReview, testing []model.Issue) tasksLoadedMsg {
 	// Sort each group individually, then combine (Under Review on top)
 	underReview = sortIssuesByUpdatedDesc(underReview)
 	testing = sortIssuesByUpdatedDesc(testing)
@@ -169,7 +234,7 @@ func (m *Model) loadTasksCmd() tea.Msg {
 	todo = sortIssuesByUpdatedDesc(todo)
 
 	return tasksLoadedMsg{
-		user:            userResult.user,
+		user:            user,
 		reportTasks:     inProgress,
 		todoTasks:       todo,
 		processingTasks: processingTasks,
@@ -195,33 +260,107 @@ func sortIssuesByUpdatedDesc(issues []model.Issue) []model.Issue {
 	return issues
 }
 
-// loadWorklogsCmd fetches worklogs and enriches them (background - Phase 2)
+// loadWorklogsCmd streams worklogs page by page and enriches them (background - Phase 2)
 func (m *Model) loadWorklogsCmd() tea.Cmd {
 	return tea.Tick(10*time.Millisecond, func(t time.Time) tea.Msg {
 		if m.state.User == nil {
 			return worklogsLoadedMsg{err: fmt.Errorf("user not loaded")}
 		}
 
-		// Fetch worklogs using the user's account ID
-		worklogs, err := m.tempoClient.FetchLastSixDaysWorklogs(m.state.User.AccountID)
+		pages := make(chan worklogsPageMsg)
+		base := m.syncBase
+		ctx := m.loads.current()
+		go func() {
+			defer close(pages)
+
+			// Each message carries everything loaded so far, so panels can render progressively
+			var loaded []model.Worklog
+			onPage := func(page []model.Worklog) error {
+				enriched, err := m.tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, page)
+				if err != nil {
+					return err
+				}
+				loaded = append(loaded, enriched...)
+				shown := loaded
+				if base.Incremental() {
+					from, to := api.LastSixDaysRange()
+					shown = cache.MergeWorklogs(base.Worklogs, loaded, from, to)
+				}
+				pages <- worklogsPageMsg{worklogs: shown, dateGroups: model.GroupWorklogsByDate(shown), pages: pages}
+				return nil
+			}
+
+			var err error
+			if base.Incremental() {
+				// Only worklogs changed since the snapshot; deletions made elsewhere show up on refresh (r)
+				from, to := api.LastSixDaysRange()
+				err = m.tempoClient.StreamWorklogsUpdatedSinceContext(ctx, m.state.User.AccountID, from, to, base.SyncedAt, onPage)
+			} else {
+				err = m.tempoClient.StreamLastSixDaysWorklogsContext(ctx, m.state.User.AccountID, onPage)
+			}
+			if err != nil {
+				pages <- worklogsPageMsg{err: err, pages: pages}
+			}
+		}()
+
+		return waitForWorklogsPage(pages)()
+	})
+}
+
+// scheduleLoadedMsg carries the required time per day for the loaded worklog range
+type scheduleLoadedMsg struct {
+	schedule []model.ScheduleDay
+}
+
+// loadScheduleCmd loads the work schedule up to yesterday (today is still in progress)
+func (m *Model) loadScheduleCmd() tea.Cmd {
+	return func() tea.Msg {
+		from, _ := api.LastSixDaysRange()
+		until := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+
+		ctx := m.loads.current()
+		settings := m.config.GetTimesheetSettings()
+		schedule, err := settings.Schedule(ctx, m.tempoClient, from, until)
+		if ctx.Err() != nil {
+			return nil // Superseded by a refresh, which loads the schedule again
+		}
 		if err != nil {
-			return worklogsLoadedMsg{err: err}
+			// Fall back to the configured hours if Tempo's schedule is unavailable
+			schedule, _ = settings.ConfigSchedule(from, until)
 		}
+		return scheduleLoadedMsg{schedule: schedule}
+	}
+}
 
-		// Enrich with issue details
-		enriched, err := m.tempoClient.EnrichWorklogsWithIssueDetails(worklogs)
+// workAttributesLoadedMsg carries the work attributes configured in Tempo
+type workAttributesLoadedMsg struct {
+	attributes []model.WorkAttribute
+}
+
+// loadWorkAttributesCmd loads the Tempo work attributes offered when logging time
+func (m *Model) loadWorkAttributesCmd() tea.Cmd {
+	return func() tea.Msg {
+		attributes, err := m.tempoClient.FetchWorkAttributesContext(m.loads.current())
 		if err != nil {
-			return worklogsLoadedMsg{err: err}
+			// Log time without attribute pickers if they cannot be loaded
+			return workAttributesLoadedMsg{}
 		}
+		return workAttributesLoadedMsg{attributes: attributes}
+	}
+}
 
-		// Group by date
-		dateGroups := groupWorklogsByDate(enriched)
-
-		return worklogsLoadedMsg{
-			worklogs:   enriched,
-			dateGroups: dateGroups,
+// waitForWorklogsPage waits for the next streamed page; a closed channel means loading finished
+func waitForWorklogsPage(pages chan worklogsPageMsg) tea.Cmd {
+	return func() tea.Msg {
+		page, ok := <-pages
+		if !ok {
+			return worklogsLoadedMsg{}
 		}
-	})
+		if page.err != nil {
+			return worklogsLoadedMsg{err: page.err}
+		}
+		return page
+	}
 }
 
 // Update handles messages and updates the model
@@ -231,6 +370,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 		m.searchBar.SetWidth(msg.Width)
+		if m.timesheetGrid != nil {
+			m.timesheetGrid.width = msg.Width
+			m.timesheetGrid.height = msg.Height
+		}
 		return m, nil
 
 	case spinner.TickMsg:
@@ -280,11 +423,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.reportPreviewModal = updatedModal
 			return m, cmd
 		}
+		if m.copyWorklogsModal != nil && m.copyWorklogsModal.IsActive() {
+			updatedModal, cmd := m.copyWorklogsModal.Update(msg)
+			m.copyWorklogsModal = updatedModal
+			return m, cmd
+		}
+		if m.timesheetGrid != nil && m.timesheetGrid.IsActive() {
+			updatedModal, cmd := m.timesheetGrid.Update(msg)
+			m.timesheetGrid = updatedModal
+			return m, cmd
+		}
 		if m.statusModal != nil && m.statusModal.IsActive() {
 			updatedModal, cmd := m.statusModal.Update(msg)
 			m.statusModal = updatedModal
 			return m, cmd
 		}
+		if m.confirmDialog != nil && m.confirmDialog.IsActive() {
+			updatedModal, cmd := m.confirmDialog.Update(msg)
+			dialog := updatedModal.(ConfirmDialogModel)
+			m.confirmDialog = &dialog
+			return m, cmd
+		}
 		return m.handleKeyPress(msg)
 
 	case transitionsFetchedMsg:
@@ -298,12 +457,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.buddy.TriggerSpeech("log_time")
 		}
 		ctx := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config)
+		if msg.task != nil {
+			// Log to the modal's task, which differs from the selection for timers
+			ctx.SelectedTask = msg.task
+		}
+		m.timerStopPending = msg.fromTimer
+
+		action := actions.NewLogTimeAction(msg.timeValue, msg.description, msg.date).WithExtras(msg.extras)
+		return m, m.actionExecutor.ExecuteAction(action, ctx)
+
+	case timesheetWeekRequestMsg:
+		return m, m.loadTimesheetWeekCmd(msg.weekStart)
+
+	case timesheetWeekLoadedMsg:
+		if m.timesheetGrid != nil {
+			m.timesheetGrid.SetWeek(msg)
+		}
+		return m, nil
+
+	case gridLogTimeMsg:
+		m.logTimeModal = NewLogTimeModalForDate(msg.task, msg.date, m.tempoClient, m.state.User.AccountID).
+			SetWorkAttributes(m.state.WorkAttributes)
+		return m, nil
+
+	case copyWorklogsSubmittedMsg:
+		m.copyWorklogsModal = nil
+		ctx := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config)
+
+		action := actions.NewCopyWorklogsAction(msg.entries, msg.date)
+		return m, m.actionExecutor.ExecuteAction(action, ctx)
+
+	case worklogEditSubmittedMsg:
+		m.logTimeModal = nil
+		ctx := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config)
 
-		action := actions.NewLogTimeAction(msg.timeValue, msg.description, msg.date)
+		action := actions.NewEditWorklogAction(msg.worklog, msg.timeValue, msg.description, msg.date)
+		return m, m.actionExecutor.ExecuteAction(action, ctx)
+
+	case worklogDeleteConfirmedMsg:
+		m.confirmDialog = nil
+		ctx := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config)
+
+		action := actions.NewDeleteWorklogAction(msg.worklog)
 		return m, m.actionExecutor.ExecuteAction(action, ctx)
 
 	case statusChangeConfirmedMsg:
-		m.state.StatusMessage = fmt.Sprintf("Changing status to %s...", msg.targetStatus)
+		m.state.StatusMessage = fmt.Sprintf("Changing status to %s...", msg.targetStatus.Name)
 		m.statusModal = nil
 		if m.buddy != nil {
 			m.buddy.TriggerSpeech("status_change")
@@ -316,6 +515,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.actionExecutor.ExecuteAction(action, ctx)
 
 	case tasksLoadedMsg:
+		m.syncedAt = msg.syncedAt
+		m.state.Offline = false
 		m.state.User = msg.user
 		m.state.ReportTasks = msg.reportTasks
 		m.state.TodoTasks = msg.todoTasks
@@ -340,28 +541,83 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		)
 
 	case worklogsLoadedMsg:
+		if errors.Is(msg.err, context.Canceled) {
+			return m, nil // A newer load replaces this one
+		}
 		m.state.WorklogsLoading = false
+		var gridCmd tea.Cmd
+		if m.timesheetGrid != nil && m.timesheetGrid.IsActive() {
+			// Show time logged from the grid once the refresh completes
+			gridCmd = m.timesheetGrid.Request()
+		}
+		if msg.err != nil && outbox.IsOffline(msg.err) && m.syncBase != nil {
+			// Keep the cached worklogs shown
+			return m.handleSyncFailed(syncFailedMsg{err: msg.err})
+		}
 		if msg.err != nil {
-			m.state.StatusMessage = fmt.Sprintf("Tasks ready. Worklog error: %v", msg.err)
+			m.state.StatusMessage = fmt.Sprintf("Tasks ready. Worklog error: %s", friendlyError(msg.err))
 			if m.reportPreviewModal != nil && m.reportPreviewModal.IsPending() {
 				m.buildPendingReport()
 			}
-			return m, nil
+			return m, gridCmd
 		}
-		m.state.Worklogs = msg.worklogs
-		m.state.DateGroups = msg.dateGroups
 		m.state.StatusMessage = fmt.Sprintf("Loaded %d tasks, %d worklogs",
 			len(m.state.ReportTasks)+len(m.state.TodoTasks)+len(m.state.ProcessingTasks),
-			len(msg.worklogs))
+			len(m.state.Worklogs))
 
 		if m.reportPreviewModal != nil && m.reportPreviewModal.IsPending() {
 			m.buildPendingReport()
 		}
 
-		return m, nil
+		// Later refreshes fetch everything again, so changes made elsewhere are never missed
+		m.syncBase = nil
+		return m, tea.Batch(gridCmd, m.saveCacheCmd())
+
+	case worklogsPageMsg:
+		m.state.Worklogs = msg.worklogs
+		m.state.DateGroups = msg.dateGroups
+		m.state.ClampTimelogSelection()
+		m.state.StatusMessage = fmt.Sprintf("Loaded %d tasks. Loading time data... (%d worklogs so far)",
+			len(m.state.ReportTasks)+len(m.state.TodoTasks)+len(m.state.ProcessingTasks),
+			len(msg.worklogs))
+		return m, waitForWorklogsPage(msg.pages)
+
+	case timerTickMsg:
+		return m, m.timerTickCmd()
+
+	case outboxTickMsg:
+		if len(m.outboxEntries) == 0 {
+			m.reloadOutbox() // Pick up writes queued by the CLI
+		}
+		if len(m.outboxEntries) == 0 && m.state.Offline && !m.state.Loading {
+			// Check whether the network is back
+			m.state.Loading = true
+			return m, tea.Batch(m.loadTasksCmd, m.outboxTickCmd())
+		}
+		if len(m.outboxEntries) == 0 {
+			return m, m.outboxTickCmd()
+		}
+		return m, m.flushOutboxCmd()
+
+	case outboxFlushedMsg:
+		return m.handleOutboxFlushed(msg)
+
+	case cacheLoadedMsg:
+		return m.handleCacheLoaded(msg)
+
+	case syncFailedMsg:
+		return m.handleSyncFailed(msg)
 
 	case startPhase2Msg:
-		return m, m.loadWorklogsCmd()
+		return m, tea.Batch(m.loadWorklogsCmd(), m.loadScheduleCmd(), m.loadWorkAttributesCmd())
+
+	case scheduleLoadedMsg:
+		m.state.Schedule = msg.schedule
+		return m, nil
+
+	case workAttributesLoadedMsg:
+		m.state.WorkAttributes = msg.attributes
+		return m, nil
 
 	case buddyTickMsg:
 		if m.buddy != nil {
@@ -396,7 +652,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case errMsg:
 		m.state.Error = msg.error
 		m.state.Loading = false
-		m.state.StatusMessage = fmt.Sprintf("Error: %v", msg.error)
+		m.state.StatusMessage = fmt.Sprintf("Error: %s", friendlyError(msg.error))
 		return m, nil
 
 	case statusMsg:
@@ -442,6 +698,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Action lifecycle messages
 	case actions.ActionStartedMsg:
+		if m.state.Offline && blockedOffline(msg.Action) {
+			return m, func() tea.Msg {
+				return actions.ActionFailedMsg{ActionName: msg.ActionName, Error: fmt.Errorf("offline, showing cached data (read-only)")}
+			}
+		}
+
 		// Action has been validated and is starting execution
 		m.state.CurrentAction = &state.ActionState{
 			Name:      msg.ActionName,
@@ -456,6 +718,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Now execute the actual action
 		ctx := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config)
+		ctx.Outbox = m.outboxStore
 		return m, msg.Action.Execute(ctx)
 
 	case actions.ActionCompletedMsg:
@@ -475,6 +738,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state.CurrentAction = nil // Clear current action
 		m.state.StatusMessage = fmt.Sprintf("✓ %s completed", msg.ActionName)
 
+		if _, ok := msg.Action.(*actions.LogTimeAction); ok && m.timerStopPending {
+			m.finishTimerStop()
+		}
+
+		if actions.IsQueued(msg.Result) {
+			// Offline: the write waits in the outbox, so there is nothing to verify yet
+			m.reloadOutbox()
+			if resultMap, ok := msg.Result.(map[string]interface{}); ok {
+				m.state.StatusMessage, _ = resultMap["message"].(string)
+			}
+			m.state.ActionHistory = append(m.state.ActionHistory, state.ActionResult{
+				ActionName: msg.ActionName + " (queued offline)",
+				Success:    true,
+				StartTime:  time.Now(),
+				EndTime:    time.Now(),
+			})
+			return m, nil
+		}
+
 		// Record in history
 		m.state.ActionHistory = append(m.state.ActionHistory, state.ActionResult{
 			ActionName: msg.ActionName,
@@ -586,7 +868,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// TODO: Implement state snapshots
 		m.state.StateSnapshot = nil
 		// Show error message
-		m.state.StatusMessage = fmt.Sprintf("Error: %v", msg.Error)
+		m.state.StatusMessage = fmt.Sprintf("Error: %s", friendlyError(msg.Error))
+		if m.timerStopPending {
+			m.timerStopPending = false
+			m.state.StatusMessage += " (timer is still running)"
+		}
 		m.state.CurrentAction = nil
 		return m, nil
 	}
@@ -602,6 +888,8 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	switch msg.String() {
 	case "q", "ctrl+c":
+		m.loads.stop()
+		m.weekLoads.stop()
 		return m, tea.Quit
 
 	case "k", "up":
@@ -728,6 +1016,37 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Show log time modal
 		return m.showLogTimeModal()
 
+	case "w":
+		// Weekly timesheet grid
+		return m.showTimesheetGrid()
+
+	case "t":
+		// Start a timer on the selected task, or stop the running one
+		return m.toggleTimer()
+
+	case "enter":
+		// Expand/collapse the selected day in Time Tracking
+		if m.state.ActivePanel == state.PanelTimelog {
+			m.state.ToggleDateGroup()
+		}
+		return m, nil
+
+	case "e":
+		if m.state.ActivePanel == state.PanelTimelog {
+			return m.showEditWorklogModal()
+		}
+
+	case "d":
+		if m.state.ActivePanel == state.PanelTimelog {
+			return m.showDeleteWorklogConfirm()
+		}
+
+	case "C":
+		// Copy the selected day's worklogs to another date
+		if m.state.ActivePanel == state.PanelTimelog {
+			return m.showCopyWorklogsModal()
+		}
+
 	case "y":
 		// Check if this is 'yy' (double press)
 		if m.lastKey == "y" {
@@ -750,6 +1069,10 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.state.ClearFilter()
 			return m, nil
 		}
+		if m.state.ActivePanel == state.PanelTimelog && m.state.ExpandedDate != "" {
+			m.state.CollapseDateGroup()
+			return m, nil
+		}
 	}
 
 	// Reset lastKey for any other key
@@ -812,7 +1135,7 @@ func (m Model) fetchTransitionsCmd(issueKey, currentStatus string) tea.Cmd {
 // View renders the TUI
 func (m Model) View() string {
 	if m.state.Error != nil {
-		return errorStyle.Render(fmt.Sprintf("Error: %v\n\nPress q to quit", m.state.Error))
+		return errorStyle.Render(fmt.Sprintf("Error: %s\n\nPress q to quit", friendlyError(m.state.Error)))
 	}
 
 	// Calculate responsive dimensions
@@ -887,6 +1210,11 @@ func (m Model) View() string {
 		baseView = searchView + "\n" + baseView
 	}
 
+	if m.timesheetGrid != nil && m.timesheetGrid.IsActive() {
+		// The grid stays underneath the log time modal opened from it
+		baseView = m.overlayModal(baseView, m.timesheetGrid.View())
+	}
+
 	if m.logTimeModal != nil && m.logTimeModal.active {
 		// Render modal without any placement
 		modalView := m.logTimeModal.View()
@@ -1025,6 +1353,14 @@ func (m Model) View() string {
 		return strings.Join(overlayLines, "\n")
 	}
 
+	if m.confirmDialog != nil && m.confirmDialog.IsActive() {
+		return m.overlayModal(baseView, m.confirmDialog.View())
+	}
+
+	if m.copyWorklogsModal != nil && m.copyWorklogsModal.IsActive() {
+		return m.overlayModal(baseView, m.copyWorklogsModal.View())
+	}
+
 	// Overlay history if active (lowest priority overlay)
 	if m.showingHistory {
 		historyView := m.renderHistoryOverlay()
@@ -1275,41 +1611,83 @@ func (m Model) renderTimelogPanelWithSize(width, height int) string {
 			maxItems = 1
 		}
 
-		displayGroups := m.state.DateGroups
+		// Flatten date groups, with the worklogs of the expanded group nested below it
+		type timelogRow struct {
+			text     string
+			selected bool
+		}
+		missing := make(map[string]int)
+		for _, gap := range timesheet.Check(m.state.Schedule, m.state.DateGroups) {
+			missing[gap.Date] = gap.MissingSeconds()
+		}
+
+		var rows []timelogRow
+		selectedRow := 0
+		rowWidth := panelContentWidth(width) - 2
+		if m.buddy != nil && m.buddy.Visible {
+			rowWidth -= buddy.BuddySpriteWidth + 2
+		}
+		for i, group := range m.state.DateGroups {
+			hours := float64(group.TotalSeconds) / 3600.0
+			taskWord := "task"
+			if len(group.Worklogs) != 1 {
+				taskWord = "tasks"
+			}
+			expanded := m.state.IsDateGroupExpanded(group.Date)
+			groupSelected := i == selectedIdx && (!expanded || m.state.SelectedWorklogIndex < 0)
+			if groupSelected {
+				selectedRow = len(rows)
+			}
+			text := fmt.Sprintf("%s • %.1fh • %d %s", group.DisplayDate, hours, len(group.Worklogs), taskWord)
+			if seconds := missing[group.Date]; seconds > 0 {
+				text += fmt.Sprintf(" • ⚠ -%.1fh", float64(seconds)/3600.0)
+			}
+			rows = append(rows, timelogRow{text: truncateDisplayWidth(text, rowWidth), selected: groupSelected})
+
+			if !expanded {
+				continue
+			}
+			for j, w := range group.Worklogs {
+				worklogSelected := i == selectedIdx && j == m.state.SelectedWorklogIndex
+				if worklogSelected {
+					selectedRow = len(rows)
+				}
+				text := fmt.Sprintf("  %s • %s", w.Issue.Key, formatTimeString(w.TimeSpentSeconds))
+				if w.Description != "" {
+					text += " • " + w.Description
+				}
+				rows = append(rows, timelogRow{text: truncateDisplayWidth(text, rowWidth), selected: worklogSelected})
+			}
+		}
+
+		displayRows := rows
 		start := 0
 
-		if len(m.state.DateGroups) > maxItems {
-			start = selectedIdx - maxItems/2
+		if len(rows) > maxItems {
+			start = selectedRow - maxItems/2
 			if start < 0 {
 				start = 0
 			}
 			end := start + maxItems
-			if end > len(m.state.DateGroups) {
-				end = len(m.state.DateGroups)
+			if end > len(rows) {
+				end = len(rows)
 				start = end - maxItems
 				if start < 0 {
 					start = 0
 				}
 			}
-			displayGroups = m.state.DateGroups[start:end]
+			displayRows = rows[start:end]
 		}
 
-		for i, group := range displayGroups {
+		for _, row := range displayRows {
 			prefix := "  "
 			style := itemStyle
-			actualIdx := start + i
 
-			if actualIdx == selectedIdx && isActive {
+			if row.selected && isActive {
 				prefix = "▶ "
 				style = selectedItemStyle
 			}
-			hours := float64(group.TotalSeconds) / 3600.0
-			taskWord := "task"
-			if len(group.Worklogs) != 1 {
-				taskWord = "tasks"
-			}
-			text := fmt.Sprintf("%s • %.1fh • %d %s", group.DisplayDate, hours, len(group.Worklogs), taskWord)
-			items = append(items, style.Render(prefix+text))
+			items = append(items, style.Render(prefix+row.text))
 		}
 	}
 
@@ -1323,6 +1701,9 @@ func (m Model) renderTimelogPanelWithSize(width, height int) string {
 	} else {
 		counter = "0 groups"
 	}
+	if totalMissing := timesheet.TotalMissing(timesheet.Check(m.state.Schedule, m.state.DateGroups)); totalMissing > 0 && !m.state.WorklogsLoading {
+		counter = fmt.Sprintf("⚠ %.1fh missing │ %s", float64(totalMissing)/3600.0, counter)
+	}
 
 	if m.buddy == nil || !m.buddy.Visible {
 		return RenderWithTitleAndCounter(content, width, height, borderTitle, counter, isActive, RoundedBorder)
@@ -1628,7 +2009,22 @@ func (m Model) renderDetailsPanelWithSize(width, height int) string {
 }
 
 func (m Model) renderStatusBar() string {
-	helpText := "q: quit | j/k: move | 1/2/3/4/0: panels | o: open | c: copy report | yy: copy task | r: refresh | i: log time | /: search | H: history | V: buddy"
+	helpText := "q: quit | j/k: move | 1/2/3/4/0: panels | o: open | c: copy report | yy: copy task | r: refresh | i: log time | t: timer | w: week | /: search | H: history | V: buddy"
+	if m.state.ActivePanel == state.PanelTimelog {
+		helpText = "q: quit | j/k: move | enter: expand day | e: edit worklog | d: delete worklog | C: copy day | esc: collapse | r: refresh | H: history"
+	}
+
+	if timerStatus := m.renderTimerStatus(); timerStatus != "" {
+		helpText = timerStatus + " | " + helpText
+	}
+
+	if outboxStatus := m.renderOutboxStatus(); outboxStatus != "" {
+		helpText = outboxStatus + " | " + helpText
+	}
+
+	if offlineStatus := m.renderOfflineStatus(); offlineStatus != "" {
+		helpText = offlineStatus + " | " + helpText
+	}
 
 	if m.buddy != nil {
 		face := buddy.RenderBuddyInline(m.buddy)
@@ -1681,7 +2077,8 @@ type errMsg struct {
 
 // renderHistoryOverlay renders the action history overlay
 func (m Model) renderHistoryOverlay() string {
-	if len(m.state.ActionHistory) == 0 {
+	queued := m.renderOutboxHistory()
+	if len(m.state.ActionHistory) == 0 && len(queued) == 0 {
 		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
 			lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(1, 2).Render("No action history"),
 		)
@@ -1690,7 +2087,7 @@ func (m Model) renderHistoryOverlay() string {
 	var historyItems []string
 	// Show in reverse order (newest first)
 	startIndex := len(m.state.ActionHistory) - 1
-	endIndex := startIndex - (m.height - 4) // Reserve space for borders/title
+	endIndex := startIndex - (m.height - 4 - len(queued)) // Reserve space for borders/title and queued writes
 	if endIndex < 0 {
 		endIndex = -1
 	}
@@ -1722,7 +2119,7 @@ func (m Model) renderHistoryOverlay() string {
 		historyItems = append(historyItems, item)
 	}
 
-	historyList := strings.Join(historyItems, "\n")
+	historyList := strings.Join(append(queued, historyItems...), "\n")
 
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -1737,43 +2134,5 @@ func (m Model) renderHistoryOverlay() string {
 
 // buildPendingReport populates a pending report preview modal with loaded data
 func (m *Model) buildPendingReport() {
-	var prevDate time.Time
-	if len(m.state.DateGroups) > 0 {
-		prevDate, _ = time.Parse("2006-01-02", m.state.DateGroups[0].Date)
-	}
-	prevWorklogs := getPreviousDayWorklogs(m.state.Worklogs)
-	content := report.BuildMainReport(prevWorklogs, m.state.ReportTasks, prevDate)
-	m.reportPreviewModal.BuildReport(content)
-}
-
-// groupWorklogsByDate groups worklogs by date
-func groupWorklogsByDate(worklogs []model.Worklog) []model.DateGroup {
-	groupMap := make(map[string]*model.DateGroup)
-
-	for _, log := range worklogs {
-		if _, exists := groupMap[log.StartDate]; !exists {
-			groupMap[log.StartDate] = &model.DateGroup{
-				Date:         log.StartDate,
-				DisplayDate:  log.StartDate, // TODO: Format properly
-				Worklogs:     []model.Worklog{},
-				TotalSeconds: 0,
-			}
-		}
-		group := groupMap[log.StartDate]
-		group.Worklogs = append(group.Worklogs, log)
-		group.TotalSeconds += log.TimeSpentSeconds
-	}
-
-	// Convert to slice
-	var groups []model.DateGroup
-	for _, group := range groupMap {
-		groups = append(groups, *group)
-	}
-
-	// Sort by date descending (newest first)
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].Date > groups[j].Date
-	})
-
-	return groups
+	m.reportPreviewModal.BuildReport(m.buildReportData())
 }
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/cache"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+	"github.com/yourusername/jira-daily-report/internal/tui/actions"
+)
+
+// cacheLoadedMsg carries the snapshot saved by the previous session (nil if there is none)
+type cacheLoadedMsg struct {
+	snapshot *cache.Snapshot
+}
+
+// syncFailedMsg is sent when a sync fails for lack of network while data is already shown
+type syncFailedMsg struct {
+	err error
+}
+
+// loadCacheCmd reads the snapshot saved for the configured site and user
+func (m *Model) loadCacheCmd() tea.Msg {
+	if m.cacheStore == nil {
+		return cacheLoadedMsg{}
+	}
+	snapshot, err := m.cacheStore.Load(m.config.GetJiraServer(), m.config.GetUsername())
+	if err != nil {
+		// A corrupt cache is replaced by the next sync
+		return cacheLoadedMsg{}
+	}
+	return cacheLoadedMsg{snapshot: snapshot}
+}
+
+// handleCacheLoaded shows the cached data right away, then syncs the changes since it was saved
+func (m Model) handleCacheLoaded(msg cacheLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.snapshot == nil {
+		return m, m.loadTasksCmd
+	}
+
+	snapshot := msg.snapshot
+	m.syncBase = snapshot
+	m.tempoClient.SeedIssueCache(snapshot.Worklogs)
+
+	inProgress, todo, underReview, testing := api.CategorizeTasks(snapshot.Issues, m.config.GetStatusMapping())
+	tasks := newTasksLoadedMsg(snapshot.User, inProgress, todo, underReview, testing)
+	m.state.User = tasks.user
+	m.state.ReportTasks = tasks.reportTasks
+	m.state.TodoTasks = tasks.todoTasks
+	m.state.ProcessingTasks = tasks.processingTasks
+	m.state.Worklogs = snapshot.Worklogs
+	m.state.DateGroups = model.GroupWorklogsByDate(snapshot.Worklogs)
+	m.state.ClampTimelogSelection()
+	m.state.Loading = false
+	m.state.WorklogsLoading = true
+	m.state.StatusMessage = fmt.Sprintf("Showing data cached %s. Syncing...", formatCacheAge(snapshot.SyncedAt))
+
+	return m, m.loadTasksCmd
+}
+
+// syncError turns a failed sync into a message. Network failures keep the data already
+// shown (cached or from an earlier sync) and switch to read-only offline mode.
+func (m *Model) syncError(err error) tea.Msg {
+	if outbox.IsOffline(err) && (m.syncBase != nil || m.state.User != nil) {
+		return syncFailedMsg{err: err}
+	}
+	return errMsg{err}
+}
+
+// handleSyncFailed switches to offline mode
+func (m Model) handleSyncFailed(msg syncFailedMsg) (tea.Model, tea.Cmd) {
+	m.state.Loading = false
+	m.state.WorklogsLoading = false
+	m.state.Offline = true
+
+	dataTime := m.syncedAt
+	if m.syncBase != nil {
+		dataTime = m.syncBase.SyncedAt
+	}
+	m.state.StatusMessage = fmt.Sprintf("📴 Offline: showing data from %s (read-only)", formatCacheAge(dataTime))
+	return m, nil
+}
+
+// saveCacheCmd saves the synced tasks and worklogs for the next startup
+func (m *Model) saveCacheCmd() tea.Cmd {
+	if m.cacheStore == nil || m.syncedAt.IsZero() {
+		return nil
+	}
+
+	var issues []model.Issue
+	issues = append(issues, m.state.ReportTasks...)
+	issues = append(issues, m.state.TodoTasks...)
+	issues = append(issues, m.state.ProcessingTasks...)
+	snapshot := cache.Snapshot{
+		Site:     m.config.GetJiraServer(),
+		Username: m.config.GetUsername(),
+		SyncedAt: m.syncedAt,
+		User:     m.state.User,
+		Issues:   issues,
+		Worklogs: append([]model.Worklog(nil), m.state.Worklogs...),
+	}
+
+	store := m.cacheStore
+	return func() tea.Msg {
+		// Failing to save only costs a slower next startup
+		_ = store.Save(snapshot)
+		return nil
+	}
+}
+
+// blockedOffline reports whether an action cannot run while offline. Logging time and
+// changing status are queued in the outbox instead.
+func blockedOffline(action actions.Action) bool {
+	switch action.(type) {
+	case *actions.EditWorklogAction, *actions.DeleteWorklogAction, *actions.CopyWorklogsAction:
+		return true
+	}
+	return false
+}
+
+// renderOfflineStatus returns the offline indicator for the status bar
+func (m Model) renderOfflineStatus() string {
+	if !m.state.Offline {
+		return ""
+	}
+	return "📴 offline (read-only)"
+}
+
+// formatCacheAge describes when data was synced, e.g. "today 09:15" or "2026-04-01 17:30"
+func formatCacheAge(syncedAt time.Time) string {
+	if syncedAt.IsZero() {
+		return "earlier"
+	}
+	if syncedAt.Format("2006-01-02") == time.Now().Format("2006-01-02") {
+		return "today " + syncedAt.Format("15:04")
+	}
+	return syncedAt.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/cache"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/actions"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+func TestSyncFailureWhileOfflineKeepsCachedDataReadOnly(t *testing.T) {
+	m := Model{state: state.NewState(), syncBase: &cache.Snapshot{SyncedAt: time.Now()}}
+	m.state.User = &model.User{AccountID: "me"}
+
+	assert.IsType(t, errMsg{}, m.syncError(errors.New("jira returned 401")), "server errors are still shown")
+
+	msg := m.syncError(&net.OpError{Op: "dial", Err: errors.New("connection refused")})
+	require.IsType(t, syncFailedMsg{}, msg)
+
+	updated, _ := m.Update(msg)
+	m = updated.(Model)
+	assert.True(t, m.state.Offline)
+	assert.Nil(t, m.state.Error, "cached data stays visible")
+	assert.Contains(t, m.state.StatusMessage, "Offline: showing data from today")
+	assert.Equal(t, "📴 offline (read-only)", m.renderOfflineStatus())
+
+	_, cmd := m.Update(actions.ActionStartedMsg{ActionName: "Delete Worklog", Action: actions.NewDeleteWorklogAction(model.Worklog{TempoWorklogID: 1})})
+	require.NotNil(t, cmd)
+	failed, ok := cmd().(actions.ActionFailedMsg)
+	require.True(t, ok, "edits are blocked while offline")
+	assert.Contains(t, failed.Error.Error(), "read-only")
+}
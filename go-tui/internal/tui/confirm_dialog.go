@@ -15,6 +15,8 @@ type ConfirmDialogModel struct {
 	cursor    int // 0 = Yes, 1 = No
 	width     int
 	height    int
+	active    bool
+	onConfirm tea.Cmd // Run on confirm when embedded in the main view (nil = quit like a standalone program)
 }
 
 // NewConfirmDialogModel creates a new confirmation dialog
@@ -24,9 +26,19 @@ func NewConfirmDialogModel(message string) ConfirmDialogModel {
 		cursor:  0, // Default to "Yes"
 		width:   60,
 		height:  10,
+		active:  true,
 	}
 }
 
+// NewConfirmDialogWithAction creates a confirmation dialog that runs onConfirm
+// instead of quitting, for use as a modal inside the main view
+func NewConfirmDialogWithAction(message string, onConfirm tea.Cmd) *ConfirmDialogModel {
+	m := NewConfirmDialogModel(message)
+	m.cursor = 1 // Default to "No" for destructive actions
+	m.onConfirm = onConfirm
+	return &m
+}
+
 // Init initializes the dialog
 func (m ConfirmDialogModel) Init() tea.Cmd {
 	return nil
@@ -39,10 +51,21 @@ func (m ConfirmDialogModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch msg.String() {
 		case "q", "esc", "n":
 			m.cancelled = true
+			m.active = false
+			if m.onConfirm != nil {
+				return m, nil
+			}
 			return m, tea.Quit
 
 		case "enter", "y":
 			m.confirmed = (m.cursor == 0) || msg.String() == "y"
+			m.active = false
+			if m.onConfirm != nil {
+				if m.confirmed {
+					return m, m.onConfirm
+				}
+				return m, nil
+			}
 			return m, tea.Quit
 
 		case "h", "left":
@@ -105,6 +128,11 @@ func (m ConfirmDialogModel) IsConfirmed() bool {
 	return m.confirmed
 }
 
+// IsActive returns whether the dialog is still waiting for an answer
+func (m ConfirmDialogModel) IsActive() bool {
+	return m.active
+}
+
 // IsCancelled returns whether the dialog was cancelled
 func (m ConfirmDialogModel) IsCancelled() bool {
 	return m.cancelled
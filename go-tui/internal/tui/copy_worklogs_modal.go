@@ -0,0 +1,240 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/actions"
+)
+
+// copyEntry is a worklog in the copy list with its adjustable time
+type copyEntry struct {
+	worklog  model.Worklog
+	seconds  int
+	selected bool
+}
+
+// CopyWorklogsModal copies the worklogs of a day to another date
+type CopyWorklogsModal struct {
+	active    bool
+	mode      int // 0=list, 1=time input, 2=date input
+	group     model.DateGroup
+	entries   []copyEntry
+	cursor    int
+	timeInput textinput.Model
+	dateInput textinput.Model
+	err       string
+}
+
+// copyWorklogsSubmittedMsg is sent when the copy is confirmed
+type copyWorklogsSubmittedMsg struct {
+	entries []actions.WorklogCopy
+	date    string
+}
+
+// NewCopyWorklogsModal creates a copy modal for a date group with every worklog selected
+func NewCopyWorklogsModal(group model.DateGroup) *CopyWorklogsModal {
+	ti := textinput.New()
+	ti.Placeholder = "e.g., 2h, 1.5h, 30m"
+	ti.CharLimit = 20
+	ti.Width = 40
+
+	di := textinput.New()
+	di.Placeholder = "today, yesterday, last friday, or YYYY-MM-DD"
+	di.CharLimit = 20
+	di.Width = 40
+	di.SetValue("today")
+
+	entries := make([]copyEntry, 0, len(group.Worklogs))
+	for _, w := range group.Worklogs {
+		entries = append(entries, copyEntry{worklog: w, seconds: w.TimeSpentSeconds, selected: true})
+	}
+
+	return &CopyWorklogsModal{
+		active:    true,
+		group:     group,
+		entries:   entries,
+		timeInput: ti,
+		dateInput: di,
+	}
+}
+
+// IsActive returns true if the modal is active
+func (m *CopyWorklogsModal) IsActive() bool {
+	return m.active
+}
+
+// Update handles input for the copy modal
+func (m *CopyWorklogsModal) Update(msg tea.KeyMsg) (*CopyWorklogsModal, tea.Cmd) {
+	switch m.mode {
+	case 1:
+		return m.updateTimeInput(msg)
+	case 2:
+		return m.updateDateInput(msg)
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.active = false
+	case "j", "down":
+		if m.cursor < len(m.entries)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case " ", "x":
+		if len(m.entries) > 0 {
+			m.entries[m.cursor].selected = !m.entries[m.cursor].selected
+		}
+	case "a":
+		// Select all, or none if everything is already selected
+		all := m.selectedCount() == len(m.entries)
+		for i := range m.entries {
+			m.entries[i].selected = !all
+		}
+	case "e":
+		if len(m.entries) > 0 {
+			m.mode = 1
+			m.err = ""
+			m.timeInput.SetValue(formatTimeString(m.entries[m.cursor].seconds))
+			m.timeInput.Focus()
+		}
+	case "enter":
+		if m.selectedCount() == 0 {
+			m.err = "Select at least one worklog"
+			return m, nil
+		}
+		m.mode = 2
+		m.err = ""
+		m.dateInput.Focus()
+	}
+	return m, nil
+}
+
+// updateTimeInput adjusts the time of the entry under the cursor
+func (m *CopyWorklogsModal) updateTimeInput(msg tea.KeyMsg) (*CopyWorklogsModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = 0
+		m.err = ""
+		return m, nil
+	case "enter":
+		seconds, err := parseTimeString(m.timeInput.Value())
+		if err != nil {
+			m.err = err.Error()
+			return m, nil
+		}
+		m.entries[m.cursor].seconds = seconds
+		m.entries[m.cursor].selected = true
+		m.mode = 0
+		m.err = ""
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.timeInput, cmd = m.timeInput.Update(msg)
+	return m, cmd
+}
+
+// updateDateInput reads the target date and submits the copy
+func (m *CopyWorklogsModal) updateDateInput(msg tea.KeyMsg) (*CopyWorklogsModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = 0
+		m.err = ""
+		return m, nil
+	case "enter":
+		date, err := dateutil.ParseWorklogDate(m.dateInput.Value())
+		if err != nil {
+			m.err = err.Error()
+			return m, nil
+		}
+		m.active = false
+		entries := m.selectedCopies()
+		return m, func() tea.Msg {
+			return copyWorklogsSubmittedMsg{entries: entries, date: date}
+		}
+	}
+	var cmd tea.Cmd
+	m.dateInput, cmd = m.dateInput.Update(msg)
+	return m, cmd
+}
+
+// selectedCount returns how many entries will be copied
+func (m *CopyWorklogsModal) selectedCount() int {
+	count := 0
+	for _, entry := range m.entries {
+		if entry.selected {
+			count++
+		}
+	}
+	return count
+}
+
+// selectedCopies returns the selected entries with their adjusted times
+func (m *CopyWorklogsModal) selectedCopies() []actions.WorklogCopy {
+	var copies []actions.WorklogCopy
+	for _, entry := range m.entries {
+		if !entry.selected {
+			continue
+		}
+		copies = append(copies, actions.WorklogCopy{Worklog: entry.worklog, Seconds: entry.seconds})
+	}
+	return copies
+}
+
+// View renders the modal
+func (m *CopyWorklogsModal) View() string {
+	if !m.active {
+		return ""
+	}
+
+	var lines []string
+	total := 0
+	for i, entry := range m.entries {
+		check := "[ ]"
+		if entry.selected {
+			check = "[x]"
+			total += entry.seconds
+		}
+		text := fmt.Sprintf("%s %s • %s", check, entry.worklog.Issue.Key, formatTimeString(entry.seconds))
+		if entry.worklog.Description != "" {
+			text += " • " + entry.worklog.Description
+		}
+		text = truncateDisplayWidth(text, 54)
+
+		if i == m.cursor && m.mode == 0 {
+			lines = append(lines, selectedItemStyle.Render("▶ "+text))
+		} else {
+			lines = append(lines, itemStyle.Render("  "+text))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, fmt.Sprintf("Selected: %d worklogs, %s", m.selectedCount(), formatTimeString(total)))
+	lines = append(lines, "")
+
+	switch m.mode {
+	case 1:
+		lines = append(lines, fmt.Sprintf("Time for %s:", m.entries[m.cursor].worklog.Issue.Key), "", m.timeInput.View(), "")
+		lines = append(lines, itemStyle.Foreground(colorMuted).Render("[Enter] Save  [ESC] Back"))
+	case 2:
+		lines = append(lines, fmt.Sprintf("Copy to date (%s):", dateutil.WorklogDateFormatHelp), "", m.dateInput.View(), "")
+		lines = append(lines, itemStyle.Foreground(colorMuted).Render("[Enter] Copy  [ESC] Back"))
+	default:
+		lines = append(lines, itemStyle.Foreground(colorMuted).Render("[Space] Toggle  [a] All  [e] Edit time  [Enter] Continue  [ESC] Cancel"))
+	}
+
+	content := strings.Join(lines, "\n")
+	if m.err != "" {
+		content += "\n\n" + errorStyle.Render("Error: "+m.err)
+	}
+
+	title := fmt.Sprintf("Copy Worklogs - %s", m.group.DisplayDate)
+	return modalStyle.Width(60).Render(titleStyle.Render(title) + "\n" + content)
+}
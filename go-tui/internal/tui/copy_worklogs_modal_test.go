@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func TestCopyWorklogsModalSubmitsSelectedEntries(t *testing.T) {
+	modal := NewCopyWorklogsModal(model.DateGroup{
+		Date:        "2026-04-01",
+		DisplayDate: "2026-04-01",
+		Worklogs: []model.Worklog{
+			{Issue: model.WorklogIssue{ID: 1, Key: "GRAP-1"}, TimeSpentSeconds: 3600, Description: "Standup"},
+			{Issue: model.WorklogIssue{ID: 2, Key: "GRAP-2"}, TimeSpentSeconds: 7200, Attributes: []model.WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}}},
+		},
+	})
+
+	// Deselect the first entry and change the second to 30m
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	modal.timeInput.SetValue("30m")
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	modal.dateInput.SetValue("2026-04-02")
+	var cmd tea.Cmd
+	modal, cmd = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.False(t, modal.IsActive())
+
+	msg, ok := cmd().(copyWorklogsSubmittedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "2026-04-02", msg.date)
+	require.Len(t, msg.entries, 1)
+	assert.Equal(t, "GRAP-2", msg.entries[0].Worklog.Issue.Key)
+	assert.Equal(t, 1800, msg.entries[0].Seconds)
+	assert.Equal(t, []model.WorkAttributeValue{{Key: "_WorkType_", Value: "Dev"}}, msg.entries[0].Worklog.Attributes)
+}
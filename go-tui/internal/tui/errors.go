@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"errors"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+)
+
+// friendlyError describes common API failures in plain words, e.g.
+// "failed to fetch issue: not found (deleted, or you lack permission to see it)"
+func friendlyError(err error) string {
+	var hint string
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		hint = "Jira or Tempo rejected the credentials; check the API tokens with 'jira-report config init'"
+	case errors.Is(err, api.ErrRateLimited):
+		hint = "rate limited by Jira or Tempo; try again in a minute"
+	case errors.Is(err, api.ErrNotFound):
+		hint = "not found (deleted, or you lack permission to see it)"
+	case outbox.IsOffline(err):
+		return "no network connection: " + err.Error()
+	default:
+		return err.Error()
+	}
+
+	var statusErr *api.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Op + ": " + hint
+	}
+	return hint
+}
@@ -0,0 +1,20 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+)
+
+func TestFriendlyErrorExplainsTypedErrors(t *testing.T) {
+	notFound := fmt.Errorf("failed to delete worklog: %w", &api.StatusError{Op: "failed to delete worklog", StatusCode: 404, Status: "404 Not Found"})
+	assert.Equal(t, "failed to delete worklog: not found (deleted, or you lack permission to see it)", friendlyError(notFound))
+
+	assert.Contains(t, friendlyError(&api.StatusError{Op: "failed to fetch tasks", StatusCode: 401}), "rejected the credentials")
+	assert.Contains(t, friendlyError(&api.StatusError{Op: "failed to fetch tasks", StatusCode: 429}), "try again in a minute")
+	assert.Equal(t, "boom", friendlyError(errors.New("boom")))
+}
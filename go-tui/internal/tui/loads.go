@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"context"
+	"sync"
+)
+
+// loadCanceler hands out one context per data load and cancels the previous one, so a
+// refresh supersedes a load still in flight and quitting stops every request. Model copies
+// share it by pointer; a nil loadCanceler never cancels.
+type loadCanceler struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// newLoadCanceler creates a loadCanceler with no load in flight
+func newLoadCanceler() *loadCanceler {
+	return &loadCanceler{}
+}
+
+// next cancels the current load and returns the context for a new one
+func (l *loadCanceler) next() context.Context {
+	if l == nil {
+		return context.Background()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.cancel != nil {
+		l.cancel()
+	}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+	return l.ctx
+}
+
+// current returns the context of the latest load, for requests that belong to it
+func (l *loadCanceler) current() context.Context {
+	if l == nil {
+		return context.Background()
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.ctx == nil {
+		l.ctx, l.cancel = context.WithCancel(context.Background())
+	}
+	return l.ctx
+}
+
+// stop cancels the current load
+func (l *loadCanceler) stop() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
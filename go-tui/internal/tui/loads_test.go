@@ -0,0 +1,24 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCancelerSupersedesPreviousLoad(t *testing.T) {
+	loads := newLoadCanceler()
+
+	first := loads.next()
+	assert.Same(t, first, loads.current(), "worklog loads join the running task load")
+
+	second := loads.next()
+	assert.Error(t, first.Err(), "a refresh cancels the load it supersedes")
+	assert.NoError(t, second.Err())
+
+	loads.stop()
+	assert.Error(t, second.Err(), "quitting cancels the load in flight")
+
+	var none *loadCanceler
+	assert.NoError(t, none.next().Err(), "models built without a canceler never cancel")
+}
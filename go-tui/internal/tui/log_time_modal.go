@@ -13,12 +13,14 @@ import (
 	"github.com/yourusername/jira-daily-report/internal/api"
 	"github.com/yourusername/jira-daily-report/internal/dateutil"
 	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+	"github.com/yourusername/jira-daily-report/internal/tui/actions"
 )
 
 // LogTimeModal represents the log time modal state
 type LogTimeModal struct {
 	task          *model.Issue
-	mode          int // 0=menu, 1=time input, 2=description input, 3=date input, 4=confirm
+	mode          int // 0=menu, 1=time input, 2=description input, 3=date input, 4=confirm, 5=start time, 6=billable, 7=work attribute
 	menuChoice    int // 0=quick, 1=with desc, 2=full
 	timeInput     textinput.Model
 	descInput     textinput.Model
@@ -30,6 +32,18 @@ type LogTimeModal struct {
 	active        bool
 	tempoClient   *api.TempoClient
 	userAccountID string
+	editing       *model.Worklog // Worklog being edited (nil when logging new time)
+	fromTimer     bool           // Logging the time of a stopped timer
+
+	startInput      textinput.Model
+	billableInput   textinput.Model
+	attrInput       textinput.Model
+	startValue      string // HH:MM:SS, empty when not set
+	billableSeconds *int   // nil bills the time spent
+	workAttributes  []model.WorkAttribute
+	attrQueue       []model.WorkAttribute // Attributes still to pick, current first
+	attrCursor      int
+	attrValues      []model.WorkAttributeValue
 }
 
 // NewLogTimeModal creates a new log time modal
@@ -50,6 +64,20 @@ func NewLogTimeModal(task *model.Issue, tempoClient *api.TempoClient, userAccoun
 	dti.CharLimit = 20
 	dti.Width = 40
 
+	si := textinput.New()
+	si.Placeholder = "Optional, e.g., 09:00"
+	si.CharLimit = 8
+	si.Width = 40
+
+	bi := textinput.New()
+	bi.Placeholder = "Optional, e.g., 1h or 0 (default: time spent)"
+	bi.CharLimit = 20
+	bi.Width = 40
+
+	ai := textinput.New()
+	ai.CharLimit = 200
+	ai.Width = 40
+
 	return &LogTimeModal{
 		task:          task,
 		mode:          0, // Start with menu
@@ -57,6 +85,9 @@ func NewLogTimeModal(task *model.Issue, tempoClient *api.TempoClient, userAccoun
 		timeInput:     ti,
 		descInput:     di,
 		dateInput:     dti,
+		startInput:    si,
+		billableInput: bi,
+		attrInput:     ai,
 		dateValue:     "today",
 		active:        true,
 		tempoClient:   tempoClient,
@@ -64,6 +95,68 @@ func NewLogTimeModal(task *model.Issue, tempoClient *api.TempoClient, userAccoun
 	}
 }
 
+// NewEditWorklogModal creates a log time modal prefilled with an existing worklog.
+// It skips the menu and walks through time, description and date before confirming.
+func NewEditWorklogModal(worklog model.Worklog, tempoClient *api.TempoClient, userAccountID string) *LogTimeModal {
+	task := &model.Issue{
+		ID:  strconv.Itoa(worklog.Issue.ID),
+		Key: worklog.Issue.Key,
+		Fields: model.IssueFields{
+			Summary: worklog.Issue.Summary,
+		},
+	}
+
+	m := NewLogTimeModal(task, tempoClient, userAccountID)
+	m.editing = &worklog
+	m.mode = 1
+	m.menuChoice = 2
+	m.timeInput.SetValue(formatTimeString(worklog.TimeSpentSeconds))
+	m.descInput.SetValue(worklog.Description)
+	m.dateInput.SetValue(worklog.StartDate)
+	m.dateValue = worklog.StartDate
+
+	return m
+}
+
+// NewLogTimeModalForDate creates a log time modal for a fixed date, starting at the time input
+func NewLogTimeModalForDate(task *model.Issue, date string, tempoClient *api.TempoClient, userAccountID string) *LogTimeModal {
+	m := NewLogTimeModal(task, tempoClient, userAccountID)
+	m.mode = 1
+	m.menuChoice = 2
+	m.dateInput.SetValue(date)
+	m.dateValue = date
+	return m
+}
+
+// NewTimerLogModal creates a log time modal prefilled with the rounded time of a stopped timer
+func NewTimerLogModal(t *timer.Timer, seconds int, tempoClient *api.TempoClient, userAccountID string) *LogTimeModal {
+	task := &model.Issue{
+		ID:  t.IssueID,
+		Key: t.IssueKey,
+		Fields: model.IssueFields{
+			Summary: t.IssueSummary,
+		},
+	}
+
+	m := NewLogTimeModal(task, tempoClient, userAccountID)
+	m.fromTimer = true
+	m.mode = 1
+	m.menuChoice = 2
+	m.timeInput.SetValue(formatTimeString(seconds))
+	m.descInput.SetValue(t.Description)
+	m.dateInput.SetValue(t.StartDate())
+	m.dateValue = t.StartDate()
+
+	return m
+}
+
+// SetWorkAttributes sets the Tempo work attributes to pick when logging new time.
+// Required attributes are always asked for; the full mode asks for all of them.
+func (m *LogTimeModal) SetWorkAttributes(attributes []model.WorkAttribute) *LogTimeModal {
+	m.workAttributes = attributes
+	return m
+}
+
 // Update handles modal updates
 func (m *LogTimeModal) Update(msg tea.Msg) (*LogTimeModal, tea.Cmd) {
 	if !m.active {
@@ -83,6 +176,12 @@ func (m *LogTimeModal) Update(msg tea.Msg) (*LogTimeModal, tea.Cmd) {
 			return m.updateDateInput(msg)
 		case 4: // Confirm
 			return m.updateConfirm(msg)
+		case 5: // Start time input
+			return m.updateStartInput(msg)
+		case 6: // Billable input
+			return m.updateBillableInput(msg)
+		case 7: // Work attribute picker
+			return m.updateAttribute(msg)
 		}
 	}
 
@@ -150,7 +249,7 @@ func (m *LogTimeModal) updateTimeInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd)
 		if m.menuChoice == 0 {
 			// Quick mode: skip to confirm
 			m.dateValue = time.Now().Format("2006-01-02")
-			m.mode = 4
+			m.finishInputs()
 		} else if m.menuChoice == 1 {
 			// With description: go to description
 			m.mode = 2
@@ -181,7 +280,7 @@ func (m *LogTimeModal) updateDescInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd)
 		if m.menuChoice == 1 {
 			// With description mode: skip date, use today
 			m.dateValue = time.Now().Format("2006-01-02")
-			m.mode = 4
+			m.finishInputs()
 		} else {
 			// Full mode: go to date input
 			m.mode = 3
@@ -216,7 +315,7 @@ func (m *LogTimeModal) updateDateInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd)
 
 		m.dateValue = parsedDate
 		m.err = ""
-		m.mode = 4 // Move to confirm
+		m.finishInputs()
 	default:
 		var cmd tea.Cmd
 		m.dateInput, cmd = m.dateInput.Update(msg)
@@ -225,6 +324,168 @@ func (m *LogTimeModal) updateDateInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd)
 	return m, nil
 }
 
+// finishInputs moves on from the time, description and date inputs. New worklogs in full
+// mode also ask for start and billable time, then work attributes, before confirming.
+func (m *LogTimeModal) finishInputs() {
+	switch {
+	case m.editing != nil:
+		m.mode = 4
+	case m.menuChoice == 2:
+		m.mode = 5
+		m.startInput.Focus()
+	default:
+		m.startAttributes(false)
+	}
+}
+
+// updateStartInput handles the optional start time
+func (m *LogTimeModal) updateStartInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.active = false
+		return m, nil
+	case "enter":
+		m.startValue = ""
+		if value := strings.TrimSpace(m.startInput.Value()); value != "" {
+			startTime, err := dateutil.ParseStartTime(value)
+			if err != nil {
+				m.err = err.Error()
+				return m, nil
+			}
+			m.startValue = startTime
+		}
+		m.err = ""
+		m.mode = 6
+		m.billableInput.Focus()
+	default:
+		var cmd tea.Cmd
+		m.startInput, cmd = m.startInput.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// updateBillableInput handles the optional billable time
+func (m *LogTimeModal) updateBillableInput(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.active = false
+		return m, nil
+	case "enter":
+		m.billableSeconds = nil
+		switch value := strings.TrimSpace(m.billableInput.Value()); value {
+		case "":
+		case "0":
+			zero := 0
+			m.billableSeconds = &zero
+		default:
+			seconds, err := parseTimeString(value)
+			if err != nil {
+				m.err = err.Error()
+				return m, nil
+			}
+			m.billableSeconds = &seconds
+		}
+		m.err = ""
+		m.startAttributes(true)
+	default:
+		var cmd tea.Cmd
+		m.billableInput, cmd = m.billableInput.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// startAttributes queues the work attributes to pick: all of them, or only required ones
+func (m *LogTimeModal) startAttributes(all bool) {
+	m.attrQueue = nil
+	m.attrValues = nil
+	for _, attr := range m.workAttributes {
+		if all || attr.Required {
+			m.attrQueue = append(m.attrQueue, attr)
+		}
+	}
+	m.nextAttribute()
+}
+
+// nextAttribute shows the next queued attribute, or the confirmation once all are picked
+func (m *LogTimeModal) nextAttribute() {
+	if len(m.attrQueue) == 0 {
+		m.mode = 4
+		return
+	}
+	m.mode = 7
+	m.attrCursor = 0
+	m.attrInput.SetValue("")
+	m.attrInput.Placeholder = m.attrQueue[0].Name
+	m.attrInput.Focus()
+}
+
+// attrOptions returns the choices for the current attribute, nil for free text.
+// Optional attributes start with "" to leave them unset.
+func (m *LogTimeModal) attrOptions() []string {
+	attr := m.attrQueue[0]
+	options := attr.Options()
+	if len(options) == 0 {
+		return nil
+	}
+	if !attr.Required {
+		options = append([]string{""}, options...)
+	}
+	return options
+}
+
+// updateAttribute handles the work attribute picker
+func (m *LogTimeModal) updateAttribute(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd) {
+	attr := m.attrQueue[0]
+	options := m.attrOptions()
+
+	switch msg.String() {
+	case "esc":
+		m.active = false
+		return m, nil
+	case "enter":
+		value := strings.TrimSpace(m.attrInput.Value())
+		if options != nil {
+			value = options[m.attrCursor]
+		}
+		if value == "" && attr.Required {
+			m.err = fmt.Sprintf("%s is required", attr.Name)
+			return m, nil
+		}
+		if value != "" {
+			validated, err := model.ValidateWorkAttributes([]model.WorkAttribute{attr}, []model.WorkAttributeValue{{Key: attr.Key, Value: value}})
+			if err != nil {
+				m.err = err.Error()
+				return m, nil
+			}
+			m.attrValues = append(m.attrValues, validated...)
+		}
+		m.err = ""
+		m.attrQueue = m.attrQueue[1:]
+		m.nextAttribute()
+		return m, nil
+	}
+
+	if options != nil {
+		switch msg.String() {
+		case "j", "down":
+			if m.attrCursor < len(options)-1 {
+				m.attrCursor++
+			}
+		case "k", "up":
+			if m.attrCursor > 0 {
+				m.attrCursor--
+			}
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.attrInput, cmd = m.attrInput.Update(msg)
+	return m, cmd
+}
+
 // updateConfirm handles confirmation
 func (m *LogTimeModal) updateConfirm(msg tea.KeyMsg) (*LogTimeModal, tea.Cmd) {
 	switch msg.String() {
@@ -247,6 +508,11 @@ func (m *LogTimeModal) View() string {
 
 	var content string
 	title := fmt.Sprintf("Log Time - %s", m.task.Key)
+	if m.editing != nil {
+		title = fmt.Sprintf("Edit Worklog - %s", m.task.Key)
+	} else if m.fromTimer {
+		title = fmt.Sprintf("Stop Timer - %s", m.task.Key)
+	}
 
 	switch m.mode {
 	case 0: // Menu
@@ -259,6 +525,12 @@ func (m *LogTimeModal) View() string {
 		content = m.renderDateInput()
 	case 4: // Confirm
 		content = m.renderConfirm()
+	case 5: // Start time input
+		content = m.renderStartInput()
+	case 6: // Billable input
+		content = m.renderBillableInput()
+	case 7: // Work attribute picker
+		content = m.renderAttribute()
 	}
 
 	if m.err != "" {
@@ -312,38 +584,142 @@ func (m *LogTimeModal) renderDateInput() string {
 		itemStyle.Foreground(colorMuted).Render("[Enter] Continue  [ESC] Cancel")
 }
 
+func (m *LogTimeModal) renderStartInput() string {
+	return "Start time (optional, HH:MM):\n\n" +
+		m.startInput.View() + "\n\n" +
+		itemStyle.Foreground(colorMuted).Render("[Enter] Continue  [ESC] Cancel")
+}
+
+func (m *LogTimeModal) renderBillableInput() string {
+	return "Billable time (optional, 0 = non-billable):\n\n" +
+		m.billableInput.View() + "\n\n" +
+		itemStyle.Foreground(colorMuted).Render("[Enter] Continue  [ESC] Cancel")
+}
+
+func (m *LogTimeModal) renderAttribute() string {
+	attr := m.attrQueue[0]
+	label := attr.Name
+	if !attr.Required {
+		label += " (optional)"
+	}
+
+	options := m.attrOptions()
+	if options == nil {
+		return label + ":\n\n" +
+			m.attrInput.View() + "\n\n" +
+			itemStyle.Foreground(colorMuted).Render("[Enter] Continue  [ESC] Cancel")
+	}
+
+	lines := []string{label + ":", ""}
+	for i, option := range options {
+		name := attr.ValueName(option)
+		if option == "" {
+			name = "(none)"
+		}
+		if i == m.attrCursor {
+			lines = append(lines, selectedItemStyle.Render("▶ "+name))
+		} else {
+			lines = append(lines, itemStyle.Render("  "+name))
+		}
+	}
+	lines = append(lines, "", itemStyle.Foreground(colorMuted).Render("[↑↓/jk] Navigate  [Enter] Select  [ESC] Cancel"))
+	return strings.Join(lines, "\n")
+}
+
 func (m *LogTimeModal) renderConfirm() string {
 	summary := fmt.Sprintf("Log %s to %s on %s", m.timeValue, m.task.Key, m.dateValue)
+	if m.editing != nil {
+		summary = fmt.Sprintf("Change worklog on %s to %s on %s", m.task.Key, m.timeValue, m.dateValue)
+	}
 	if m.descValue != "" {
 		summary += fmt.Sprintf("\nDescription: %s", m.descValue)
 	}
+	if m.startValue != "" {
+		summary += fmt.Sprintf("\nStart: %s", m.startValue[:5])
+	}
+	if m.billableSeconds != nil {
+		summary += fmt.Sprintf("\nBillable: %s", formatTimeString(*m.billableSeconds))
+	}
+	for _, value := range m.attrValues {
+		summary += "\n" + m.attributeLabel(value)
+	}
 
 	return summary + "\n\n" +
 		itemStyle.Foreground(colorSuccess).Render("[Y/Enter] Confirm") + "  " +
 		itemStyle.Foreground(colorError).Render("[N/ESC] Cancel")
 }
 
+// attributeLabel formats a picked work attribute as "Name: value name"
+func (m *LogTimeModal) attributeLabel(value model.WorkAttributeValue) string {
+	for _, attr := range m.workAttributes {
+		if attr.Key == value.Key {
+			return attr.Name + ": " + attr.ValueName(value.Value)
+		}
+	}
+	return value.Key + ": " + value.Value
+}
+
 // logTimeSubmittedMsg is sent when time log is submitted
 type logTimeSubmittedMsg struct {
 	timeValue   string
 	description string
 	date        string
 	task        *model.Issue
+	fromTimer   bool
+	extras      actions.WorklogExtras
+}
+
+// worklogEditSubmittedMsg is sent when an edited worklog is submitted
+type worklogEditSubmittedMsg struct {
+	worklog     model.Worklog
+	timeValue   string
+	description string
+	date        string
 }
 
 func (m *LogTimeModal) submitWorklog() tea.Cmd {
+	if m.editing != nil {
+		return func() tea.Msg {
+			return worklogEditSubmittedMsg{
+				worklog:     *m.editing,
+				timeValue:   m.timeValue,
+				description: m.descValue,
+				date:        m.dateValue,
+			}
+		}
+	}
 	return func() tea.Msg {
 		return logTimeSubmittedMsg{
 			timeValue:   m.timeValue,
 			description: m.descValue,
 			date:        m.dateValue,
 			task:        m.task,
+			fromTimer:   m.fromTimer,
+			extras: actions.WorklogExtras{
+				StartTime:       m.startValue,
+				BillableSeconds: m.billableSeconds,
+				Attributes:      m.attrValues,
+			},
 		}
 	}
 }
 
 // Helper functions
 
+// formatTimeString formats seconds in the form accepted by parseTimeString, e.g. "2h30m"
+func formatTimeString(seconds int) string {
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	switch {
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
 func parseTimeString(s string) (int, error) {
 	s = strings.TrimSpace(s)
 	// Match patterns like "2h", "1.5h", "30m", "2h30m"
@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+func TestFormatTimeStringRoundTrips(t *testing.T) {
+	for _, seconds := range []int{1800, 3600, 9000} {
+		parsed, err := parseTimeString(formatTimeString(seconds))
+		require.NoError(t, err)
+		assert.Equal(t, seconds, parsed)
+	}
+}
+
+func TestEditWorklogModalSubmitsEditedWorklog(t *testing.T) {
+	worklog := model.Worklog{
+		TempoWorklogID:   42,
+		Issue:            model.WorklogIssue{ID: 1001, Key: "GRAP-1"},
+		TimeSpentSeconds: 5400,
+		StartDate:        "2026-04-01",
+		Description:      "Pairing",
+	}
+	modal := NewEditWorklogModal(worklog, nil, "acc")
+
+	assert.Contains(t, modal.View(), "Edit Worklog - GRAP-1")
+	assert.Equal(t, "1h30m", modal.timeInput.Value())
+
+	// Accept the prefilled time, description and date, then confirm
+	var cmd tea.Cmd
+	for i := 0; i < 4; i++ {
+		modal, cmd = modal.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	}
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(worklogEditSubmittedMsg)
+	require.True(t, ok)
+	assert.Equal(t, 42, msg.worklog.TempoWorklogID)
+	assert.Equal(t, "1h30m", msg.timeValue)
+	assert.Equal(t, "Pairing", msg.description)
+	assert.Equal(t, "2026-04-01", msg.date)
+}
+
+func TestTimelogPanelShowsExpandedWorklogs(t *testing.T) {
+	m := Model{state: state.NewState()}
+	m.state.ActivePanel = state.PanelTimelog
+	m.state.DateGroups = []model.DateGroup{{
+		Date:         "2026-04-01",
+		DisplayDate:  "2026-04-01",
+		TotalSeconds: 3600,
+		Worklogs: []model.Worklog{
+			{TempoWorklogID: 1, Issue: model.WorklogIssue{Key: "GRAP-1"}, TimeSpentSeconds: 3600, Description: strings.Repeat("long ", 40)},
+		},
+	}}
+
+	assert.NotContains(t, m.renderTimelogPanelWithSize(60, 10), "GRAP-1")
+
+	m.state.ToggleDateGroup()
+	view := m.renderTimelogPanelWithSize(60, 10)
+	assert.Contains(t, view, "GRAP-1 • 1h")
+	assert.Equal(t, 1, strings.Count(view, "▶"), "only the selected worklog is highlighted")
+}
+
+func TestTimelogPanelFlagsUnderLoggedDays(t *testing.T) {
+	m := Model{state: state.NewState()}
+	m.state.DateGroups = []model.DateGroup{{Date: "2026-04-01", DisplayDate: "2026-04-01", TotalSeconds: 5 * 3600}}
+	m.state.Schedule = []model.ScheduleDay{
+		{Date: "2026-04-01", RequiredSeconds: 8 * 3600},
+		{Date: "2026-04-02", RequiredSeconds: 8 * 3600},
+	}
+
+	view := m.renderTimelogPanelWithSize(80, 10)
+
+	assert.Contains(t, view, "⚠ -3.0h")
+	assert.Contains(t, view, "⚠ 11.0h missing", "days without worklogs count towards the total")
+}
+
+func TestLogTimeModalFullModeAsksForExtras(t *testing.T) {
+	task := &model.Issue{ID: "1001", Key: "GRAP-1"}
+	modal := NewLogTimeModalForDate(task, "2026-04-01", nil, "acc").SetWorkAttributes([]model.WorkAttribute{
+		{Key: "_WorkType_", Name: "Work Type", Type: model.WorkAttributeStaticList, Required: true, Values: []string{"Dev", "Meeting"}},
+		{Key: "_Ticket_", Name: "Ticket", Type: model.WorkAttributeInputField},
+	})
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	modal.timeInput.SetValue("2h")
+	modal, _ = modal.Update(enter) // time
+	modal, _ = modal.Update(enter) // description
+	modal, _ = modal.Update(enter) // date
+	require.Equal(t, 5, modal.mode)
+
+	modal.startInput.SetValue("9:30")
+	modal, _ = modal.Update(enter)
+	modal.billableInput.SetValue("0")
+	modal, _ = modal.Update(enter)
+
+	// Work Type is a required list: pick the second value
+	assert.Contains(t, modal.View(), "Work Type")
+	modal, _ = modal.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	modal, _ = modal.Update(enter)
+	// Ticket is optional free text: leave it empty
+	modal, _ = modal.Update(enter)
+	require.Equal(t, 4, modal.mode)
+	assert.Contains(t, modal.View(), "Work Type: Meeting")
+
+	var cmd tea.Cmd
+	modal, cmd = modal.Update(enter)
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(logTimeSubmittedMsg)
+	require.True(t, ok)
+	assert.Equal(t, "09:30:00", msg.extras.StartTime)
+	require.NotNil(t, msg.extras.BillableSeconds)
+	assert.Equal(t, 0, *msg.extras.BillableSeconds)
+	assert.Equal(t, []model.WorkAttributeValue{{Key: "_WorkType_", Value: "Meeting"}}, msg.extras.Attributes)
+}
+
+func TestLogTimeModalQuickModeAsksOnlyRequiredAttributes(t *testing.T) {
+	modal := NewLogTimeModal(&model.Issue{ID: "1001", Key: "GRAP-1"}, nil, "acc").SetWorkAttributes([]model.WorkAttribute{
+		{Key: "_Ticket_", Name: "Ticket", Type: model.WorkAttributeInputField, Required: true},
+		{Key: "_Billable_", Name: "Billable", Type: model.WorkAttributeCheckbox},
+	})
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	modal, _ = modal.Update(enter) // quick mode
+	modal.timeInput.SetValue("30m")
+	modal, _ = modal.Update(enter)
+	require.Equal(t, 7, modal.mode)
+
+	modal, _ = modal.Update(enter)
+	assert.Contains(t, modal.View(), "Ticket is required")
+
+	modal.attrInput.SetValue("OPS-7")
+	modal, _ = modal.Update(enter)
+	assert.Equal(t, 4, modal.mode, "optional attributes are skipped in quick mode")
+	assert.Equal(t, []model.WorkAttributeValue{{Key: "_Ticket_", Value: "OPS-7"}}, modal.attrValues)
+}
@@ -1,11 +1,16 @@
 package tui
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
 	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/report"
 	"github.com/yourusername/jira-daily-report/internal/tui/state"
 )
 
@@ -35,17 +40,144 @@ func (m Model) showLogTimeModal() (Model, tea.Cmd) {
 	}
 
 	selectedTask = &tasks[idx]
-	m.logTimeModal = NewLogTimeModal(selectedTask, m.tempoClient, m.state.User.AccountID)
+	m.logTimeModal = NewLogTimeModal(selectedTask, m.tempoClient, m.state.User.AccountID).
+		SetWorkAttributes(m.state.WorkAttributes)
 
 	return m, nil
 }
 
+// worklogDeleteConfirmedMsg is sent when the user confirms deleting a worklog
+type worklogDeleteConfirmedMsg struct {
+	worklog model.Worklog
+}
+
+// showEditWorklogModal opens the log time modal prefilled with the selected worklog
+func (m Model) showEditWorklogModal() (Model, tea.Cmd) {
+	worklog := m.state.SelectedWorklog()
+	if worklog == nil {
+		m.state.StatusMessage = "Expand a day with Enter and select a worklog to edit"
+		return m, nil
+	}
+
+	accountID := ""
+	if m.state.User != nil {
+		accountID = m.state.User.AccountID
+	}
+	m.logTimeModal = NewEditWorklogModal(*worklog, m.tempoClient, accountID)
+	return m, nil
+}
+
+// showDeleteWorklogConfirm asks for confirmation before deleting the selected worklog
+func (m Model) showDeleteWorklogConfirm() (Model, tea.Cmd) {
+	worklog := m.state.SelectedWorklog()
+	if worklog == nil {
+		m.state.StatusMessage = "Expand a day with Enter and select a worklog to delete"
+		return m, nil
+	}
+
+	selected := *worklog
+	message := fmt.Sprintf("Delete %s logged to %s on %s?",
+		formatTimeString(selected.TimeSpentSeconds), selected.Issue.Key, selected.StartDate)
+	m.confirmDialog = NewConfirmDialogWithAction(message, func() tea.Msg {
+		return worklogDeleteConfirmedMsg{worklog: selected}
+	})
+	return m, nil
+}
+
+// showCopyWorklogsModal opens the copy modal for the selected day in Time Tracking
+func (m Model) showCopyWorklogsModal() (Model, tea.Cmd) {
+	group := m.state.SelectedDateGroup()
+	if group == nil || len(group.Worklogs) == 0 {
+		m.state.StatusMessage = "Select a day with worklogs to copy"
+		return m, nil
+	}
+	m.copyWorklogsModal = NewCopyWorklogsModal(*group)
+	return m, nil
+}
+
+// showTimesheetGrid opens the weekly timesheet grid for the current week
+func (m Model) showTimesheetGrid() (Model, tea.Cmd) {
+	if m.state.User == nil {
+		m.state.StatusMessage = "Still loading, try again in a moment"
+		return m, nil
+	}
+	m.timesheetGrid = NewTimesheetGridModal(dateutil.WeekStart(time.Now()), m.width, m.height)
+	return m, m.timesheetGrid.Request()
+}
+
+// loadTimesheetWeekCmd loads the worklogs and work schedule for the week starting at weekStart,
+// cancelling the load of the week it replaces
+func (m Model) loadTimesheetWeekCmd(weekStart time.Time) tea.Cmd {
+	accountID := m.state.User.AccountID
+	ctx := m.weekLoads.next()
+	return func() tea.Msg {
+		from := weekStart.Format("2006-01-02")
+		to := weekStart.AddDate(0, 0, 6).Format("2006-01-02")
+
+		worklogs, err := m.tempoClient.FetchWorklogsContext(ctx, accountID, from, to)
+		if err == nil {
+			worklogs, err = m.tempoClient.EnrichWorklogsWithIssueDetailsContext(ctx, worklogs)
+		}
+		if ctx.Err() != nil {
+			return nil // Superseded by another load
+		}
+		if err != nil {
+			return timesheetWeekLoadedMsg{weekStart: weekStart, err: err}
+		}
+
+		settings := m.config.GetTimesheetSettings()
+		schedule, err := settings.Schedule(ctx, m.tempoClient, from, to)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			schedule, _ = settings.ConfigSchedule(from, to)
+		}
+
+		return timesheetWeekLoadedMsg{weekStart: weekStart, worklogs: worklogs, schedule: schedule}
+	}
+}
+
+// overlayModal draws modalView centered over baseView
+func (m Model) overlayModal(baseView, modalView string) string {
+	modalLines := strings.Split(modalView, "\n")
+	baseLines := strings.Split(baseView, "\n")
+
+	startY := (m.height - len(modalLines)) / 2
+	if startY < 0 {
+		startY = 0
+	}
+
+	overlayLines := make([]string, len(baseLines))
+	copy(overlayLines, baseLines)
+
+	for i, modalLine := range modalLines {
+		lineY := startY + i
+		if lineY >= 0 && lineY < len(overlayLines) {
+			leftPadding := (m.width - lipgloss.Width(modalLine)) / 2
+			if leftPadding < 0 {
+				leftPadding = 0
+			}
+			overlayLines[lineY] = strings.Repeat(" ", leftPadding) + modalLine
+		}
+	}
+
+	return strings.Join(overlayLines, "\n")
+}
+
 func (m Model) showReportPreviewModal() (Model, tea.Cmd) {
 	if m.state.Loading || m.state.WorklogsLoading {
-		m.reportPreviewModal = NewPendingReportPreviewModal(m.width, m.height)
+		m.reportPreviewModal = NewPendingReportPreviewModal(m.config.GetReportTemplate(), m.width, m.height)
 		return m, nil
 	}
 
+	m.reportPreviewModal = NewReportPreviewModal(m.buildReportData(), m.config.GetReportTemplate(), m.width, m.height)
+
+	return m, nil
+}
+
+// buildReportData builds the structured report from the loaded state
+func (m *Model) buildReportData() *report.Report {
 	var prevDate time.Time
 	if len(m.state.DateGroups) > 0 {
 		prevDate, _ = time.Parse("2006-01-02", m.state.DateGroups[0].Date)
@@ -53,15 +185,16 @@ func (m Model) showReportPreviewModal() (Model, tea.Cmd) {
 
 	prevWorklogs := getPreviousDayWorklogs(m.state.Worklogs)
 
-	m.reportPreviewModal = NewReportPreviewModal(
-		prevWorklogs,
-		m.state.ReportTasks,
-		prevDate,
-		m.width,
-		m.height,
-	)
+	data := report.NewReport(prevWorklogs, m.state.ReportTasks, prevDate)
+	data.BaseURL = m.config.GetJiraServer()
 
-	return m, nil
+	// Blockers are detected among the tasks already loaded in the panels
+	var loaded []model.Issue
+	loaded = append(loaded, m.state.ReportTasks...)
+	loaded = append(loaded, m.state.TodoTasks...)
+	loaded = append(loaded, m.state.ProcessingTasks...)
+	data.SetBlockers(loaded, m.config.GetBlockedStatuses())
+	return data
 }
 
 func getPreviousDayWorklogs(worklogs []model.Worklog) []model.Worklog {
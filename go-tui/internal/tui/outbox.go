@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+	"github.com/yourusername/jira-daily-report/internal/tui/refresh"
+)
+
+// outboxReplayInterval is how often queued offline writes are retried
+const outboxReplayInterval = 30 * time.Second
+
+// outboxTickMsg triggers a replay of the queued offline writes
+type outboxTickMsg struct{}
+
+// outboxFlushedMsg carries the result of replaying the outbox
+type outboxFlushedMsg struct {
+	result  outbox.FlushResult
+	entries []outbox.Entry
+	err     error
+}
+
+// outboxTickCmd schedules the next replay attempt
+func (m *Model) outboxTickCmd() tea.Cmd {
+	if m.outboxStore == nil {
+		return nil
+	}
+	return tea.Tick(outboxReplayInterval, func(t time.Time) tea.Msg {
+		return outboxTickMsg{}
+	})
+}
+
+// flushOutboxCmd replays queued writes, skipping the ones the verifiers find already applied
+func (m *Model) flushOutboxCmd() tea.Cmd {
+	store := m.outboxStore
+	writer := outbox.NewWriter(m.jiraClient, m.tempoClient)
+	dedup := refresh.NewOutboxDeduper(refresh.VerifierClients{JiraClient: m.jiraClient, TempoClient: m.tempoClient})
+	return func() tea.Msg {
+		result, err := outbox.Flush(store, writer, dedup)
+		entries, _ := store.List()
+		return outboxFlushedMsg{result: result, entries: entries, err: err}
+	}
+}
+
+// handleOutboxFlushed updates the queue after a replay and reloads data if anything was written
+func (m Model) handleOutboxFlushed(msg outboxFlushedMsg) (Model, tea.Cmd) {
+	m.outboxEntries = msg.entries
+	next := m.outboxTickCmd()
+
+	if msg.err != nil {
+		m.state.StatusMessage = fmt.Sprintf("Failed to replay queued writes: %v", msg.err)
+		return m, next
+	}
+	written := msg.result.Replayed + msg.result.Skipped
+	if written == 0 {
+		return m, next
+	}
+
+	m.state.StatusMessage = fmt.Sprintf("📤 Back online: replayed %d queued writes", written)
+	if msg.result.Remaining > 0 {
+		m.state.StatusMessage += fmt.Sprintf(", %d still queued", msg.result.Remaining)
+	}
+	m.state.Loading = true
+	return m, tea.Batch(m.loadTasksCmd, next)
+}
+
+// reloadOutbox refreshes the queued entries shown in the status bar and history
+func (m *Model) reloadOutbox() {
+	if m.outboxStore == nil {
+		return
+	}
+	if entries, err := m.outboxStore.List(); err == nil {
+		m.outboxEntries = entries
+	}
+}
+
+// renderOutboxStatus returns the queued writes indicator for the status bar
+func (m Model) renderOutboxStatus() string {
+	if len(m.outboxEntries) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("📥 %d queued", len(m.outboxEntries))
+}
+
+// renderOutboxHistory lists the queued writes for the history overlay
+func (m Model) renderOutboxHistory() []string {
+	if len(m.outboxEntries) == 0 {
+		return nil
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Queued offline (%d)", len(m.outboxEntries)))}
+	for _, entry := range m.outboxEntries {
+		item := fmt.Sprintf("%s %s %s",
+			lipgloss.NewStyle().Foreground(colorWarning).Render("📥"),
+			lipgloss.NewStyle().Width(10).Render(entry.CreatedAt.Format("15:04:05")),
+			entry.Summary(),
+		)
+		if entry.Attempts > 0 && entry.LastError != "" {
+			item += lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render(fmt.Sprintf(" (%s)", entry.LastError))
+		}
+		lines = append(lines, item)
+	}
+	return append(lines, "")
+}
@@ -0,0 +1,29 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+func TestQueuedOutboxEntriesShowInStatusAndHistory(t *testing.T) {
+	store := outbox.NewStoreAt(filepath.Join(t.TempDir(), "outbox.json"))
+	m := Model{state: state.NewState(), outboxStore: store}
+
+	m.reloadOutbox()
+	assert.Empty(t, m.renderOutboxStatus(), "nothing queued hides the indicator")
+
+	_, err := store.Add(outbox.NewTransitionEntry("GRAP-9", "31", "Done"))
+	require.NoError(t, err)
+	m.reloadOutbox()
+
+	assert.Equal(t, "📥 1 queued", m.renderOutboxStatus())
+	history := m.renderOutboxHistory()
+	require.Len(t, history, 3)
+	assert.Contains(t, history[1], "Move GRAP-9 to 'Done'")
+}
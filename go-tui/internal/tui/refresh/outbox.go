@@ -0,0 +1,74 @@
+package refresh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+)
+
+// clockSkew allows for the local clock running ahead of Tempo's when comparing creation times
+const clockSkew = time.Minute
+
+// OutboxDeduper skips queued writes that already reached Jira or Tempo. Create one per flush:
+// it fetches the worklogs once, before anything is replayed, and each worklog it matches
+// covers a single entry.
+type OutboxDeduper struct {
+	Clients  VerifierClients
+	worklogs map[string][]model.Worklog // By account ID
+	claimed  map[int]bool               // Tempo worklog IDs already matched to an entry
+}
+
+// NewOutboxDeduper creates a deduper for one flush
+func NewOutboxDeduper(clients VerifierClients) *OutboxDeduper {
+	return &OutboxDeduper{
+		Clients:  clients,
+		worklogs: make(map[string][]model.Worklog),
+		claimed:  make(map[int]bool),
+	}
+}
+
+// Applied reports whether a matching worklog or status already exists
+func (d *OutboxDeduper) Applied(entry outbox.Entry) (bool, error) {
+	switch {
+	case entry.Kind == outbox.KindWorklog && entry.Worklog != nil:
+		// Only a request that may have reached Tempo can have left a worklog behind
+		if !entry.Ambiguous {
+			return false, nil
+		}
+		return d.worklogApplied(entry)
+	case entry.Kind == outbox.KindTransition && entry.Transition != nil:
+		verifier := GetVerifierForStatusChange(entry.IssueKey, entry.Transition.TargetStatus)
+		return verifier.Verify(nil, nil, d.Clients)
+	}
+	return false, nil
+}
+
+// worklogApplied looks for an unclaimed worklog like the entry's, created since it was first attempted
+func (d *OutboxDeduper) worklogApplied(entry outbox.Entry) (bool, error) {
+	request := entry.Worklog
+	worklogs, ok := d.worklogs[request.AuthorAccountID]
+	if !ok {
+		fetched, err := d.Clients.TempoClient.FetchLastSixDaysWorklogs(request.AuthorAccountID)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch worklogs: %w", err)
+		}
+		worklogs = fetched
+		d.worklogs[request.AuthorAccountID] = worklogs
+	}
+
+	since := entry.CreatedAt.Add(-clockSkew)
+	for _, worklog := range worklogs {
+		if d.claimed[worklog.TempoWorklogID] ||
+			worklog.Issue.ID != request.IssueID ||
+			worklog.TimeSpentSeconds != request.TimeSpentSeconds ||
+			worklog.StartDate != request.StartDate ||
+			worklog.CreatedAt.Before(since) {
+			continue
+		}
+		d.claimed[worklog.TempoWorklogID] = true
+		return true, nil
+	}
+	return false, nil
+}
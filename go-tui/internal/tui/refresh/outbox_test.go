@@ -0,0 +1,81 @@
+package refresh
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/outbox"
+)
+
+// newTempoServer serves one existing 1h worklog on issue 10001, created at created, and
+// counts the worklogs created through it
+func newTempoServer(t *testing.T, created time.Time, creates *int) VerifierClients {
+	today := time.Now().Format("2006-01-02")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /rest/tempo-timesheets/4/worklogs/search":
+			fmt.Fprintf(w, `[{"tempoWorklogId":7,"timeSpentSeconds":3600,"started":"%s 09:00:00.000","dateCreated":"%s","worker":"jdoe","issue":{"id":10001,"key":"GRAP-1"}}]`,
+				today, created.Format("2006-01-02 15:04:05.000"))
+		case "POST /rest/tempo-timesheets/4/worklogs":
+			*creates++
+			fmt.Fprintf(w, `[{"tempoWorklogId":%d,"started":"%s 09:00:00.000","issue":{"id":10001,"key":"GRAP-1"}}]`, 100+*creates, today)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	jiraClient := api.NewServerJiraClient(server.URL, "pat")
+	return VerifierClients{JiraClient: jiraClient, TempoClient: api.NewTempoServerClient(jiraClient)}
+}
+
+// queueMeetings queues two identical 1h worklogs on GRAP-1 for today
+func queueMeetings(t *testing.T, ambiguous bool, attempted time.Time) *outbox.Store {
+	store := outbox.NewStoreAt(filepath.Join(t.TempDir(), "outbox.json"))
+	request := model.WorklogRequest{IssueID: 10001, TimeSpentSeconds: 3600, StartDate: time.Now().Format("2006-01-02"), AuthorAccountID: "jdoe"}
+	for i := 0; i < 2; i++ {
+		entry := outbox.NewWorklogEntry("GRAP-1", request)
+		entry.CreatedAt = attempted
+		entry.Ambiguous = ambiguous
+		_, err := store.Add(entry)
+		require.NoError(t, err)
+	}
+	return store
+}
+
+func TestOutboxDeduperReplaysIdenticalWorklogs(t *testing.T) {
+	// The identical worklog was logged an hour before the outage
+	creates := 0
+	clients := newTempoServer(t, time.Now().Add(-time.Hour), &creates)
+	store := queueMeetings(t, false, time.Now())
+
+	result, err := outbox.Flush(store, outbox.NewWriter(clients.JiraClient, clients.TempoClient), NewOutboxDeduper(clients))
+	require.NoError(t, err)
+
+	assert.Equal(t, outbox.FlushResult{Replayed: 2}, result)
+	assert.Equal(t, 2, creates, "both queued meetings are logged")
+}
+
+func TestOutboxDeduperMatchesEachWorklogOnce(t *testing.T) {
+	// One of the two timed-out requests reached Tempo after all
+	attempted := time.Now().Add(-10 * time.Minute)
+	creates := 0
+	clients := newTempoServer(t, attempted.Add(5*time.Second), &creates)
+	store := queueMeetings(t, true, attempted)
+
+	result, err := outbox.Flush(store, outbox.NewWriter(clients.JiraClient, clients.TempoClient), NewOutboxDeduper(clients))
+	require.NoError(t, err)
+
+	assert.Equal(t, outbox.FlushResult{Replayed: 1, Skipped: 1}, result)
+	assert.Equal(t, 1, creates, "only the request that never arrived is replayed")
+}
@@ -3,13 +3,11 @@ package tui
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/yourusername/jira-daily-report/internal/model"
 	"github.com/yourusername/jira-daily-report/internal/report"
 )
 
@@ -17,6 +15,9 @@ import (
 type ReportPreviewModal struct {
 	active       bool
 	pending      bool
+	data         *report.Report
+	format       report.Format
+	templatePath string
 	content      string
 	scrollOffset int
 	maxScroll    int
@@ -31,27 +32,30 @@ type reportCopiedMsg struct {
 }
 
 // NewReportPreviewModal creates a new report preview modal
-func NewReportPreviewModal(worklogs []model.Worklog, inProgress []model.Issue, prevDate time.Time, width, height int) *ReportPreviewModal {
-	content := report.BuildMainReport(worklogs, inProgress, prevDate)
-
-	return &ReportPreviewModal{
+func NewReportPreviewModal(data *report.Report, templatePath string, width, height int) *ReportPreviewModal {
+	m := &ReportPreviewModal{
 		active:       true,
 		pending:      false,
-		content:      content,
+		format:       report.FormatText,
+		templatePath: templatePath,
 		scrollOffset: 0,
 		width:        width,
 		height:       height,
 	}
+	m.BuildReport(data)
+	return m
 }
 
 // NewPendingReportPreviewModal creates a modal that shows loading until data is ready
-func NewPendingReportPreviewModal(width, height int) *ReportPreviewModal {
+func NewPendingReportPreviewModal(templatePath string, width, height int) *ReportPreviewModal {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 	return &ReportPreviewModal{
 		active:       true,
 		pending:      true,
+		format:       report.FormatText,
+		templatePath: templatePath,
 		scrollOffset: 0,
 		width:        width,
 		height:       height,
@@ -65,10 +69,25 @@ func (m *ReportPreviewModal) IsPending() bool {
 }
 
 // BuildReport populates the report content and clears the pending state
-func (m *ReportPreviewModal) BuildReport(content string) {
-	m.content = content
+func (m *ReportPreviewModal) BuildReport(data *report.Report) {
+	m.data = data
 	m.pending = false
 	m.scrollOffset = 0
+	m.renderContent()
+}
+
+// renderContent renders the report data in the currently selected format
+func (m *ReportPreviewModal) renderContent() {
+	renderer, err := report.NewRendererWithTemplate(m.format, m.templatePath)
+	if err != nil {
+		m.content = fmt.Sprintf("Failed to load report template: %v", err)
+		return
+	}
+	content, err := renderer.Render(m.data)
+	if err != nil {
+		content = fmt.Sprintf("Failed to render report: %v", err)
+	}
+	m.content = content
 }
 
 // IsActive returns true if the modal is active
@@ -110,6 +129,13 @@ func (m *ReportPreviewModal) Update(msg tea.KeyMsg) (*ReportPreviewModal, tea.Cm
 		// Copy report to clipboard and close
 		return m, m.copyReport()
 
+	case "f":
+		// Cycle output format (text, markdown, slack, html, json)
+		m.format = report.NextFormat(m.format)
+		m.scrollOffset = 0
+		m.renderContent()
+		return m, nil
+
 	case "g":
 		// Go to top
 		m.scrollOffset = 0
@@ -130,7 +156,7 @@ func (m *ReportPreviewModal) copyReport() tea.Cmd {
 		if err := clipboard.WriteAll(m.content); err != nil {
 			return errMsg{fmt.Errorf("failed to copy report: %w", err)}
 		}
-		return reportCopiedMsg{message: "Report copied to clipboard!"}
+		return reportCopiedMsg{message: fmt.Sprintf("Report copied to clipboard (%s)!", m.format)}
 	}
 }
 
@@ -249,8 +275,8 @@ func (m *ReportPreviewModal) View() string {
 	}
 
 	// Build the modal content
-	title := "📋 Daily Report Preview" + scrollIndicator
-	footer := "y: copy | j/k: scroll | g/G: top/bottom | esc: close"
+	title := fmt.Sprintf("📋 Daily Report Preview (%s)", m.format) + scrollIndicator
+	footer := "y: copy | f: format | j/k: scroll | g/G: top/bottom | esc: close"
 
 	// Style definitions
 	titleStyle := lipgloss.NewStyle().
@@ -27,10 +27,14 @@ type State struct {
 	YesterdayTasks       []model.Issue // Yesterday's tasks
 	Worklogs             []model.Worklog
 	DateGroups           []model.DateGroup
+	Schedule             []model.ScheduleDay   // Required time per day, for timesheet gap detection
+	WorkAttributes       []model.WorkAttribute // Tempo work attributes offered when logging time
 	ActivePanel          PanelType
 	SelectedIndices      map[PanelType]int
 	SelectedTask         *model.Issue // Currently selected task for details
 	TimeTrackingExpanded bool         // Whether time tracking panel is expanded
+	ExpandedDate         string       // Date of the date group showing its worklogs ("" = none)
+	SelectedWorklogIndex int          // Selected worklog within the expanded date group (-1 = the group itself)
 	LastTaskPanel        PanelType    // Last task panel viewed (for Details panel)
 	DetailsScrollOffset  int          // Scroll position in Details panel
 	DetailsScrollMax     int          // Maximum scroll offset (calculated from content)
@@ -41,6 +45,7 @@ type State struct {
 	WorklogsLoadStep     string       // Current loading step description
 	StatusMessage        string
 	Error                error
+	Offline              bool // Sync failed for lack of network; cached data is shown read-only
 
 	// Action tracking fields (new in action framework)
 	CurrentAction   *ActionState    // Currently executing action, if any
@@ -124,6 +129,7 @@ func NewState() *State {
 		ActivePanel:          PanelReport,
 		SelectedIndices:      make(map[PanelType]int),
 		TimeTrackingExpanded: false,
+		SelectedWorklogIndex: -1,
 		Loading:              true, // Start with loading true
 		WorklogsLoading:      false,
 		StatusMessage:        "",
@@ -165,9 +171,7 @@ func (s *State) GetTasks(panel PanelType) []model.Issue {
 // MoveSelectionUp moves selection up
 func (s *State) MoveSelectionUp() {
 	if s.ActivePanel == PanelTimelog {
-		if s.SelectedIndices[s.ActivePanel] > 0 {
-			s.SelectedIndices[s.ActivePanel]--
-		}
+		s.moveTimelogSelectionUp()
 	} else {
 		tasks := s.GetCurrentTasks()
 		if len(tasks) > 0 && s.SelectedIndices[s.ActivePanel] > 0 {
@@ -179,9 +183,7 @@ func (s *State) MoveSelectionUp() {
 // MoveSelectionDown moves selection down
 func (s *State) MoveSelectionDown() {
 	if s.ActivePanel == PanelTimelog {
-		if s.SelectedIndices[s.ActivePanel] < len(s.DateGroups)-1 {
-			s.SelectedIndices[s.ActivePanel]++
-		}
+		s.moveTimelogSelectionDown()
 	} else {
 		tasks := s.GetCurrentTasks()
 		if len(tasks) > 0 && s.SelectedIndices[s.ActivePanel] < len(tasks)-1 {
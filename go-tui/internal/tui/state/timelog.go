@@ -0,0 +1,135 @@
+package state
+
+import "github.com/yourusername/jira-daily-report/internal/model"
+
+// SelectedDateGroup returns the date group selected in the Time Tracking panel
+func (s *State) SelectedDateGroup() *model.DateGroup {
+	idx := s.SelectedIndices[PanelTimelog]
+	if idx < 0 || idx >= len(s.DateGroups) {
+		return nil
+	}
+	return &s.DateGroups[idx]
+}
+
+// IsDateGroupExpanded reports whether the group for date shows its worklogs
+func (s *State) IsDateGroupExpanded(date string) bool {
+	return s.ExpandedDate != "" && s.ExpandedDate == date
+}
+
+// ToggleDateGroup expands the selected date group, or collapses it if already expanded
+func (s *State) ToggleDateGroup() {
+	group := s.SelectedDateGroup()
+	if group == nil {
+		return
+	}
+	if s.IsDateGroupExpanded(group.Date) {
+		s.CollapseDateGroup()
+		return
+	}
+	s.ExpandedDate = group.Date
+	s.SelectedWorklogIndex = 0
+}
+
+// CollapseDateGroup collapses the expanded date group, keeping the group selected
+func (s *State) CollapseDateGroup() {
+	s.ExpandedDate = ""
+	s.SelectedWorklogIndex = -1
+}
+
+// SelectedWorklog returns the worklog selected inside the expanded date group, if any
+func (s *State) SelectedWorklog() *model.Worklog {
+	group := s.SelectedDateGroup()
+	if group == nil || !s.IsDateGroupExpanded(group.Date) {
+		return nil
+	}
+	if s.SelectedWorklogIndex < 0 || s.SelectedWorklogIndex >= len(group.Worklogs) {
+		return nil
+	}
+	return &group.Worklogs[s.SelectedWorklogIndex]
+}
+
+// RemoveWorklog drops a worklog from the loaded worklogs and date groups
+func (s *State) RemoveWorklog(worklogID int) {
+	worklogs := s.Worklogs[:0:0]
+	for _, w := range s.Worklogs {
+		if w.TempoWorklogID != worklogID {
+			worklogs = append(worklogs, w)
+		}
+	}
+	s.Worklogs = worklogs
+
+	groups := s.DateGroups[:0:0]
+	for _, group := range s.DateGroups {
+		kept := group.Worklogs[:0:0]
+		total := 0
+		for _, w := range group.Worklogs {
+			if w.TempoWorklogID == worklogID {
+				continue
+			}
+			kept = append(kept, w)
+			total += w.TimeSpentSeconds
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		group.Worklogs = kept
+		group.TotalSeconds = total
+		groups = append(groups, group)
+	}
+	s.DateGroups = groups
+
+	s.ClampTimelogSelection()
+}
+
+// ClampTimelogSelection keeps the Time Tracking selection valid after worklogs change
+func (s *State) ClampTimelogSelection() {
+	if s.SelectedIndices[PanelTimelog] >= len(s.DateGroups) {
+		s.SelectedIndices[PanelTimelog] = len(s.DateGroups) - 1
+	}
+	if s.SelectedIndices[PanelTimelog] < 0 {
+		s.SelectedIndices[PanelTimelog] = 0
+	}
+
+	if s.ExpandedDate == "" {
+		return
+	}
+	group := s.SelectedDateGroup()
+	if group == nil || group.Date != s.ExpandedDate {
+		s.CollapseDateGroup()
+		return
+	}
+	if s.SelectedWorklogIndex >= len(group.Worklogs) {
+		s.SelectedWorklogIndex = len(group.Worklogs) - 1
+	}
+}
+
+// moveTimelogSelectionUp moves through date groups and the worklogs of the expanded group
+func (s *State) moveTimelogSelectionUp() {
+	if group := s.SelectedDateGroup(); group != nil && s.IsDateGroupExpanded(group.Date) && s.SelectedWorklogIndex >= 0 {
+		s.SelectedWorklogIndex--
+		return
+	}
+	if s.SelectedIndices[PanelTimelog] == 0 {
+		return
+	}
+	s.SelectedIndices[PanelTimelog]--
+
+	// Entering an expanded group from below lands on its last worklog
+	s.SelectedWorklogIndex = -1
+	if group := s.SelectedDateGroup(); group != nil && s.IsDateGroupExpanded(group.Date) {
+		s.SelectedWorklogIndex = len(group.Worklogs) - 1
+	}
+}
+
+// moveTimelogSelectionDown moves through date groups and the worklogs of the expanded group
+func (s *State) moveTimelogSelectionDown() {
+	if group := s.SelectedDateGroup(); group != nil && s.IsDateGroupExpanded(group.Date) && s.SelectedWorklogIndex < len(group.Worklogs)-1 {
+		s.SelectedWorklogIndex++
+		return
+	}
+	if s.SelectedIndices[PanelTimelog] >= len(s.DateGroups)-1 {
+		return
+	}
+	s.SelectedIndices[PanelTimelog]++
+	s.SelectedWorklogIndex = -1
+}
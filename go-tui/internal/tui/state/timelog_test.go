@@ -0,0 +1,67 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+func newTimelogState() *State {
+	s := NewState()
+	s.ActivePanel = PanelTimelog
+	s.DateGroups = []model.DateGroup{
+		{Date: "2026-04-02", TotalSeconds: 3600, Worklogs: []model.Worklog{
+			{TempoWorklogID: 1, TimeSpentSeconds: 1800},
+			{TempoWorklogID: 2, TimeSpentSeconds: 1800},
+		}},
+		{Date: "2026-04-01", TotalSeconds: 7200, Worklogs: []model.Worklog{
+			{TempoWorklogID: 3, TimeSpentSeconds: 7200},
+		}},
+	}
+	s.Worklogs = append(append([]model.Worklog{}, s.DateGroups[0].Worklogs...), s.DateGroups[1].Worklogs...)
+	return s
+}
+
+func TestTimelogNavigationThroughExpandedGroup(t *testing.T) {
+	s := newTimelogState()
+	assert.Nil(t, s.SelectedWorklog(), "collapsed groups have no selected worklog")
+
+	s.ToggleDateGroup()
+	require.NotNil(t, s.SelectedWorklog())
+	assert.Equal(t, 1, s.SelectedWorklog().TempoWorklogID)
+
+	s.MoveSelectionDown()
+	assert.Equal(t, 2, s.SelectedWorklog().TempoWorklogID)
+
+	s.MoveSelectionDown()
+	assert.Equal(t, 1, s.SelectedIndices[PanelTimelog], "moving past the last worklog selects the next group")
+	assert.Nil(t, s.SelectedWorklog())
+
+	s.MoveSelectionUp()
+	assert.Equal(t, 0, s.SelectedIndices[PanelTimelog])
+	require.NotNil(t, s.SelectedWorklog())
+	assert.Equal(t, 2, s.SelectedWorklog().TempoWorklogID, "moving back up lands on the last worklog")
+
+	s.CollapseDateGroup()
+	assert.Nil(t, s.SelectedWorklog())
+	assert.Equal(t, 0, s.SelectedIndices[PanelTimelog])
+}
+
+func TestRemoveWorklogUpdatesGroups(t *testing.T) {
+	s := newTimelogState()
+	s.SelectedIndices[PanelTimelog] = 1
+	s.ToggleDateGroup()
+
+	s.RemoveWorklog(3)
+
+	assert.Len(t, s.Worklogs, 2)
+	require.Len(t, s.DateGroups, 1, "empty groups are dropped")
+	assert.Equal(t, 0, s.SelectedIndices[PanelTimelog])
+	assert.Equal(t, "", s.ExpandedDate, "expanded group that disappeared is collapsed")
+
+	s.RemoveWorklog(1)
+	assert.Equal(t, 1800, s.DateGroups[0].TotalSeconds)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/yourusername/jira-daily-report/internal/jira"
+	"github.com/yourusername/jira-daily-report/internal/model"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -129,7 +130,7 @@ func (m *StatusDialogModel) updateConfirm(msg tea.KeyMsg) (*StatusDialogModel, t
 			return statusChangeConfirmedMsg{
 				issueKey:     m.issueKey,
 				transitionID: m.selected.ID,
-				targetStatus: m.selected.To.Name,
+				targetStatus: m.selected.To,
 			}
 		}
 
@@ -234,5 +235,5 @@ func (m *StatusDialogModel) IsActive() bool {
 type statusChangeConfirmedMsg struct {
 	issueKey     string
 	transitionID string
-	targetStatus string
+	targetStatus model.Status
 }
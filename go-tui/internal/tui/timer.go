@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+	"github.com/yourusername/jira-daily-report/internal/tui/actions"
+)
+
+// timerTickMsg refreshes the elapsed time shown in the status bar
+type timerTickMsg struct{}
+
+// timerTickCmd schedules the next status bar refresh while a timer runs
+func (m *Model) timerTickCmd() tea.Cmd {
+	if m.runningTimer == nil {
+		return nil
+	}
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return timerTickMsg{}
+	})
+}
+
+// toggleTimer starts a timer on the selected task, or stops the running timer
+// and opens a prefilled log time modal for it
+func (m Model) toggleTimer() (Model, tea.Cmd) {
+	if m.timerStore == nil {
+		m.state.StatusMessage = "Timer unavailable: cannot locate the home directory"
+		return m, nil
+	}
+
+	if m.runningTimer != nil {
+		if m.state.User == nil {
+			m.state.StatusMessage = "Still loading, try again in a moment"
+			return m, nil
+		}
+		seconds := m.config.GetTimerRounding().Apply(m.runningTimer.Elapsed(time.Now()))
+		m.logTimeModal = NewTimerLogModal(m.runningTimer, seconds, m.tempoClient, m.state.User.AccountID).
+			SetWorkAttributes(m.state.WorkAttributes)
+		return m, nil
+	}
+
+	task := actions.NewActionContext(m.state, m.jiraClient, m.tempoClient, m.config).SelectedTask
+	if task == nil {
+		m.state.StatusMessage = "Select a task to start a timer"
+		return m, nil
+	}
+
+	t := &timer.Timer{
+		IssueKey:     task.Key,
+		IssueID:      task.ID,
+		IssueSummary: task.Fields.Summary,
+		StartedAt:    time.Now(),
+	}
+	if err := m.timerStore.Save(t); err != nil {
+		m.state.StatusMessage = fmt.Sprintf("Failed to start timer: %v", err)
+		return m, nil
+	}
+
+	m.runningTimer = t
+	m.state.StatusMessage = fmt.Sprintf("⏱ Timer started on %s", t.IssueKey)
+	return m, m.timerTickCmd()
+}
+
+// finishTimerStop clears the running timer once its worklog has been logged
+func (m *Model) finishTimerStop() {
+	m.timerStopPending = false
+	if err := m.timerStore.Clear(); err != nil {
+		m.state.StatusMessage = fmt.Sprintf("Logged time, but failed to clear timer: %v", err)
+		return
+	}
+	m.runningTimer = nil
+}
+
+// renderTimerStatus returns the running timer for the status bar, or ""
+func (m Model) renderTimerStatus() string {
+	if m.runningTimer == nil {
+		return ""
+	}
+	return fmt.Sprintf("⏱ %s %s", m.runningTimer.IssueKey, timer.FormatElapsed(m.runningTimer.Elapsed(time.Now())))
+}
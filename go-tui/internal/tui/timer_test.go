@@ -0,0 +1,43 @@
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/timer"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+func TestToggleTimerStartsTimerOnSelectedTask(t *testing.T) {
+	store := timer.NewStoreAt(filepath.Join(t.TempDir(), "timer.json"))
+	m := Model{state: state.NewState(), timerStore: store}
+	m.state.ActivePanel = state.PanelTodo
+	m.state.TodoTasks = []model.Issue{testPanelIssue("GRAP-7", "To Do", "Timer task")}
+
+	m, cmd := m.toggleTimer()
+
+	require.NotNil(t, m.runningTimer)
+	assert.Equal(t, "GRAP-7", m.runningTimer.IssueKey)
+	assert.NotNil(t, cmd, "a running timer ticks the status bar")
+	assert.Contains(t, m.renderTimerStatus(), "⏱ GRAP-7 0:00:0")
+
+	persisted, err := store.Load()
+	require.NoError(t, err)
+	require.NotNil(t, persisted)
+	assert.Equal(t, "GRAP-7", persisted.IssueKey)
+}
+
+func TestToggleTimerWithoutSelectionDoesNothing(t *testing.T) {
+	store := timer.NewStoreAt(filepath.Join(t.TempDir(), "timer.json"))
+	m := Model{state: state.NewState(), timerStore: store}
+	m.state.ActivePanel = state.PanelTodo
+
+	m, _ = m.toggleTimer()
+
+	assert.Nil(t, m.runningTimer)
+	assert.Equal(t, "", m.renderTimerStatus())
+}
@@ -0,0 +1,320 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// gridRow is one issue in the weekly timesheet grid
+type gridRow struct {
+	issueID int
+	key     string
+	summary string
+	cells   [7]int // Seconds logged per day, Monday first
+}
+
+// total returns the seconds logged on the issue over the week
+func (r gridRow) total() int {
+	total := 0
+	for _, seconds := range r.cells {
+		total += seconds
+	}
+	return total
+}
+
+// TimesheetGridModal shows a week as a grid of issues × days
+type TimesheetGridModal struct {
+	active    bool
+	loading   bool
+	err       error
+	weekStart time.Time
+	rows      []gridRow
+	required  [7]int // Required seconds per day, Monday first
+	cursorRow int
+	cursorCol int
+	width     int
+	height    int
+}
+
+// timesheetWeekRequestMsg asks the app to load the worklogs and schedule of a week
+type timesheetWeekRequestMsg struct {
+	weekStart time.Time
+}
+
+// timesheetWeekLoadedMsg carries the worklogs and schedule of a week
+type timesheetWeekLoadedMsg struct {
+	weekStart time.Time
+	worklogs  []model.Worklog
+	schedule  []model.ScheduleDay
+	err       error
+}
+
+// gridLogTimeMsg is sent when enter is pressed on a grid cell
+type gridLogTimeMsg struct {
+	task *model.Issue
+	date string
+}
+
+// NewTimesheetGridModal creates a grid for the week starting at weekStart (a Monday)
+func NewTimesheetGridModal(weekStart time.Time, width, height int) *TimesheetGridModal {
+	return &TimesheetGridModal{
+		active:    true,
+		loading:   true,
+		weekStart: weekStart,
+		cursorCol: todayColumn(weekStart, time.Now()),
+		width:     width,
+		height:    height,
+	}
+}
+
+// todayColumn returns the column for now if it falls in the week, otherwise Monday
+func todayColumn(weekStart, now time.Time) int {
+	days := int(now.Sub(weekStart).Hours() / 24)
+	if days < 0 || days > 6 {
+		return 0
+	}
+	return days
+}
+
+// IsActive returns true if the modal is active
+func (m *TimesheetGridModal) IsActive() bool {
+	return m.active
+}
+
+// Request returns a command asking for the current week's data
+func (m *TimesheetGridModal) Request() tea.Cmd {
+	weekStart := m.weekStart
+	return func() tea.Msg {
+		return timesheetWeekRequestMsg{weekStart: weekStart}
+	}
+}
+
+// date returns the YYYY-MM-DD date of a column
+func (m *TimesheetGridModal) date(col int) string {
+	return m.weekStart.AddDate(0, 0, col).Format("2006-01-02")
+}
+
+// SetWeek fills the grid from a week's worklogs and schedule, ignoring stale weeks
+func (m *TimesheetGridModal) SetWeek(msg timesheetWeekLoadedMsg) {
+	if !msg.weekStart.Equal(m.weekStart) {
+		return
+	}
+	m.loading = false
+	m.err = msg.err
+	if msg.err != nil {
+		return
+	}
+
+	columns := make(map[string]int, 7)
+	for col := 0; col < 7; col++ {
+		columns[m.date(col)] = col
+	}
+
+	m.required = [7]int{}
+	for _, day := range msg.schedule {
+		if col, ok := columns[day.Date]; ok {
+			m.required[col] = day.RequiredSeconds
+		}
+	}
+
+	byKey := make(map[string]*gridRow)
+	var keys []string
+	for _, w := range msg.worklogs {
+		col, ok := columns[w.StartDate]
+		if !ok {
+			continue
+		}
+		key := w.Issue.Key
+		if key == "" {
+			key = strconv.Itoa(w.Issue.ID)
+		}
+		row, exists := byKey[key]
+		if !exists {
+			row = &gridRow{issueID: w.Issue.ID, key: key, summary: w.Issue.Summary}
+			byKey[key] = row
+			keys = append(keys, key)
+		}
+		row.cells[col] += w.TimeSpentSeconds
+	}
+	sort.Strings(keys)
+
+	m.rows = make([]gridRow, 0, len(keys))
+	for _, key := range keys {
+		m.rows = append(m.rows, *byKey[key])
+	}
+	if m.cursorRow >= len(m.rows) {
+		m.cursorRow = len(m.rows) - 1
+	}
+	if m.cursorRow < 0 {
+		m.cursorRow = 0
+	}
+}
+
+// Update handles input for the grid
+func (m *TimesheetGridModal) Update(msg tea.KeyMsg) (*TimesheetGridModal, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "w":
+		m.active = false
+		return m, nil
+
+	case "h", "left":
+		if m.cursorCol > 0 {
+			m.cursorCol--
+		}
+	case "l", "right":
+		if m.cursorCol < 6 {
+			m.cursorCol++
+		}
+	case "k", "up":
+		if m.cursorRow > 0 {
+			m.cursorRow--
+		}
+	case "j", "down":
+		if m.cursorRow < len(m.rows)-1 {
+			m.cursorRow++
+		}
+
+	case "[":
+		return m, m.changeWeek(-7)
+	case "]":
+		return m, m.changeWeek(7)
+
+	case "enter":
+		if m.loading || len(m.rows) == 0 {
+			return m, nil
+		}
+		row := m.rows[m.cursorRow]
+		task := &model.Issue{
+			ID:     strconv.Itoa(row.issueID),
+			Key:    row.key,
+			Fields: model.IssueFields{Summary: row.summary},
+		}
+		date := m.date(m.cursorCol)
+		return m, func() tea.Msg {
+			return gridLogTimeMsg{task: task, date: date}
+		}
+	}
+	return m, nil
+}
+
+// changeWeek moves the grid by days and requests the new week's data
+func (m *TimesheetGridModal) changeWeek(days int) tea.Cmd {
+	m.weekStart = m.weekStart.AddDate(0, 0, days)
+	m.loading = true
+	m.err = nil
+	m.rows = nil
+	m.cursorRow = 0
+	return m.Request()
+}
+
+// formatGridHours formats seconds as hours for a grid cell, blank for zero
+func formatGridHours(seconds int) string {
+	if seconds == 0 {
+		return "·"
+	}
+	return fmt.Sprintf("%.1f", float64(seconds)/3600.0)
+}
+
+// View renders the grid
+func (m *TimesheetGridModal) View() string {
+	if !m.active {
+		return ""
+	}
+
+	modalWidth := m.width - 4
+	if modalWidth < 40 {
+		modalWidth = 40
+	}
+	contentWidth := modalWidth - 6
+
+	const cellWidth = 7
+	issueWidth := contentWidth - cellWidth*8
+	if issueWidth < 10 {
+		issueWidth = 10
+	}
+
+	cell := func(s string, width int) string {
+		return lipgloss.NewStyle().Width(width).Align(lipgloss.Right).Render(truncateDisplayWidth(s, width))
+	}
+	label := func(s string) string {
+		return lipgloss.NewStyle().Width(issueWidth).Render(truncateDisplayWidth(s, issueWidth))
+	}
+
+	weekEnd := m.weekStart.AddDate(0, 0, 6)
+	title := fmt.Sprintf("🗓  Week %s – %s", m.weekStart.Format("Jan 2"), weekEnd.Format("Jan 2, 2006"))
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(title), "")
+
+	header := label("Issue")
+	for col := 0; col < 7; col++ {
+		header += cell(m.weekStart.AddDate(0, 0, col).Format("Mon 2"), cellWidth)
+	}
+	header += cell("Total", cellWidth)
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(colorFgSecondary).Render(header))
+	lines = append(lines, strings.Repeat("─", issueWidth+cellWidth*8))
+
+	switch {
+	case m.loading:
+		lines = append(lines, itemStyle.Foreground(colorMuted).Render("Loading week..."))
+	case m.err != nil:
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Failed to load week: %v", m.err)))
+	case len(m.rows) == 0:
+		lines = append(lines, itemStyle.Foreground(colorMuted).Render("No worklogs this week"))
+	}
+
+	var dayTotals [7]int
+	weekTotal := 0
+	if !m.loading && m.err == nil {
+		for r, row := range m.rows {
+			line := label(row.key + " " + row.summary)
+			for col, seconds := range row.cells {
+				text := cell(formatGridHours(seconds), cellWidth)
+				if r == m.cursorRow && col == m.cursorCol {
+					text = lipgloss.NewStyle().Background(colorBgSelected).Foreground(colorSelected).Bold(true).Render(text)
+				}
+				line += text
+				dayTotals[col] += seconds
+			}
+			line += cell(formatGridHours(row.total()), cellWidth)
+			weekTotal += row.total()
+			lines = append(lines, line)
+		}
+	}
+
+	lines = append(lines, strings.Repeat("─", issueWidth+cellWidth*8))
+
+	totalLine := label("Total")
+	requiredLine := label("Required")
+	requiredTotal := 0
+	for col := 0; col < 7; col++ {
+		text := cell(formatGridHours(dayTotals[col]), cellWidth)
+		if m.required[col] > 0 && dayTotals[col] < m.required[col] && !m.loading {
+			text = lipgloss.NewStyle().Foreground(colorWarning).Render(text)
+		}
+		totalLine += text
+		requiredLine += cell(formatGridHours(m.required[col]), cellWidth)
+		requiredTotal += m.required[col]
+	}
+	totalLine += cell(formatGridHours(weekTotal), cellWidth)
+	requiredLine += cell(formatGridHours(requiredTotal), cellWidth)
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(totalLine))
+	lines = append(lines, lipgloss.NewStyle().Foreground(colorMuted).Render(requiredLine))
+
+	lines = append(lines, "")
+	lines = append(lines, itemStyle.Foreground(colorMuted).Render("hjkl: move │ enter: log time │ [ ]: prev/next week │ esc: close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorPrimary).
+		Padding(1, 2).
+		Width(modalWidth).
+		Render(strings.Join(lines, "\n"))
+}
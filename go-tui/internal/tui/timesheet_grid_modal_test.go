@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourusername/jira-daily-report/internal/api"
+	"github.com/yourusername/jira-daily-report/internal/model"
+	"github.com/yourusername/jira-daily-report/internal/tui/state"
+)
+
+func testGridWeek() (*TimesheetGridModal, timesheetWeekLoadedMsg) {
+	weekStart := time.Date(2026, 4, 27, 0, 0, 0, 0, time.Local)
+	grid := NewTimesheetGridModal(weekStart, 120, 40)
+	return grid, timesheetWeekLoadedMsg{
+		weekStart: weekStart,
+		worklogs: []model.Worklog{
+			{Issue: model.WorklogIssue{ID: 2, Key: "GRAP-2"}, StartDate: "2026-04-28", TimeSpentSeconds: 3600},
+			{Issue: model.WorklogIssue{ID: 1, Key: "GRAP-1"}, StartDate: "2026-04-27", TimeSpentSeconds: 7200},
+			{Issue: model.WorklogIssue{ID: 1, Key: "GRAP-1"}, StartDate: "2026-04-27", TimeSpentSeconds: 1800},
+			{Issue: model.WorklogIssue{ID: 3, Key: "GRAP-3"}, StartDate: "2026-05-04", TimeSpentSeconds: 3600},
+		},
+		schedule: []model.ScheduleDay{{Date: "2026-04-27", RequiredSeconds: 28800}},
+	}
+}
+
+func TestTimesheetGridBuildsIssueRows(t *testing.T) {
+	grid, week := testGridWeek()
+	grid.SetWeek(week)
+
+	require.Len(t, grid.rows, 2, "worklogs outside the week are ignored")
+	assert.Equal(t, "GRAP-1", grid.rows[0].key)
+	assert.Equal(t, 9000, grid.rows[0].cells[0])
+	assert.Equal(t, 3600, grid.rows[1].cells[1])
+	assert.Equal(t, 28800, grid.required[0])
+
+	view := grid.View()
+	assert.Contains(t, view, "GRAP-1")
+	assert.Contains(t, view, "2.5")
+	assert.Contains(t, view, "Required")
+}
+
+func TestTimesheetGridIgnoresStaleWeek(t *testing.T) {
+	grid, week := testGridWeek()
+	grid.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+
+	grid.SetWeek(week)
+
+	assert.True(t, grid.loading, "data for the previous week must not fill the new one")
+	assert.Empty(t, grid.rows)
+}
+
+func TestTimesheetGridEnterLogsTimeForCell(t *testing.T) {
+	grid, week := testGridWeek()
+	grid.SetWeek(week)
+	grid.cursorCol = 0
+
+	grid.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	grid.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	_, cmd := grid.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(gridLogTimeMsg)
+	require.True(t, ok)
+	assert.Equal(t, "GRAP-2", msg.task.Key)
+	assert.Equal(t, "2", msg.task.ID)
+	assert.Equal(t, "2026-04-28", msg.date)
+}
+
+func TestTimesheetWeekLoadIsCancelledByTheNextWeek(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	m := Model{
+		state:       state.NewState(),
+		tempoClient: api.NewTempoServerClient(api.NewServerJiraClient(server.URL, "pat")),
+		weekLoads:   newLoadCanceler(),
+	}
+	m.state.User = &model.User{AccountID: "jdoe"}
+
+	weekStart := time.Date(2026, 4, 27, 0, 0, 0, 0, time.Local)
+	first := m.loadTimesheetWeekCmd(weekStart)
+	m.loadTimesheetWeekCmd(weekStart.AddDate(0, 0, 7))
+
+	assert.Nil(t, first(), "the superseded week reports nothing")
+	assert.Zero(t, requests, "the superseded week sends no requests")
+}
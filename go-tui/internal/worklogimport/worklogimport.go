@@ -0,0 +1,211 @@
+// Package worklogimport parses worklogs to bulk-create in Tempo from CSV.
+package worklogimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yourusername/jira-daily-report/internal/dateutil"
+	"github.com/yourusername/jira-daily-report/internal/model"
+)
+
+// AttributePrefix marks a CSV column as a Tempo work attribute, e.g. "attr:_Account_"
+const AttributePrefix = "attr:"
+
+// Row is one worklog read from the CSV
+type Row struct {
+	Line        int // Line number in the input, for error messages
+	Date        string
+	IssueKey    string
+	Seconds     int
+	Description string
+	StartTime   string // HH:MM:SS, empty when not given
+	Attributes  []model.WorkAttributeValue
+}
+
+// RowError is a validation error for one CSV row
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// columnAliases maps accepted header names to canonical columns
+var columnAliases = map[string]string{
+	"date":        "date",
+	"startdate":   "date",
+	"issue":       "issue",
+	"key":         "issue",
+	"issuekey":    "issue",
+	"duration":    "duration",
+	"time":        "duration",
+	"timespent":   "duration",
+	"description": "description",
+	"comment":     "description",
+	"start":       "start",
+	"starttime":   "start",
+}
+
+// header holds the column index of each column present in the CSV
+type header struct {
+	columns    map[string]int
+	attributes []attributeColumn
+}
+
+// attributeColumn is a CSV column holding a work attribute value
+type attributeColumn struct {
+	index int
+	key   string
+}
+
+// parseHeader reads column positions from the header record
+func parseHeader(record []string) (header, error) {
+	h := header{columns: make(map[string]int)}
+	for i, name := range record {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // Spreadsheet exports often start with a BOM
+		}
+		if strings.HasPrefix(strings.ToLower(name), AttributePrefix) {
+			key := strings.TrimSpace(name[len(AttributePrefix):])
+			if key == "" {
+				return h, fmt.Errorf("column %d: empty work attribute key", i+1)
+			}
+			h.attributes = append(h.attributes, attributeColumn{index: i, key: key})
+			continue
+		}
+
+		normalized := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(name))
+		column, ok := columnAliases[normalized]
+		if !ok {
+			return h, fmt.Errorf("unknown column %q", name)
+		}
+		if _, dup := h.columns[column]; dup {
+			return h, fmt.Errorf("duplicate column %q", name)
+		}
+		h.columns[column] = i
+	}
+
+	for _, required := range []string{"issue", "duration"} {
+		if _, ok := h.columns[required]; !ok {
+			return h, fmt.Errorf("missing required column %q", required)
+		}
+	}
+	return h, nil
+}
+
+// field returns the trimmed value of a column, or "" if the column is absent
+func (h header) field(record []string, column string) string {
+	i, ok := h.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// Parse reads and validates every row of a CSV with a header line. Rows without a
+// date use defaultDate. Invalid rows are returned as RowErrors alongside the valid
+// rows; the error is only set when the input itself cannot be read.
+func Parse(r io.Reader, defaultDate string) ([]Row, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	record, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("input is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	h, err := parseHeader(record)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid header: %w", err)
+	}
+
+	var rows []Row
+	var rowErrors []RowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, RowError{Line: parseErr.Line, Err: parseErr.Err})
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row, err := parseRow(h, record, defaultDate)
+		row.Line = line
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Err: err})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+// parseRow validates a single record
+func parseRow(h header, record []string, defaultDate string) (Row, error) {
+	row := Row{
+		IssueKey:    strings.ToUpper(h.field(record, "issue")),
+		Description: h.field(record, "description"),
+		Date:        defaultDate,
+	}
+
+	if row.IssueKey == "" {
+		return row, errors.New("missing issue key")
+	}
+
+	seconds, err := dateutil.ParseDuration(h.field(record, "duration"))
+	if err != nil {
+		return row, fmt.Errorf("invalid duration: %w", err)
+	}
+	if seconds <= 0 {
+		return row, errors.New("invalid duration: must be greater than zero")
+	}
+	row.Seconds = seconds
+
+	if value := h.field(record, "date"); value != "" {
+		date, err := dateutil.ParseWorklogDate(value)
+		if err != nil {
+			return row, err
+		}
+		row.Date = date
+	}
+	if row.Date == "" {
+		return row, errors.New("missing date")
+	}
+
+	if value := h.field(record, "start"); value != "" {
+		startTime, err := dateutil.ParseStartTime(value)
+		if err != nil {
+			return row, err
+		}
+		row.StartTime = startTime
+	}
+
+	for _, attr := range h.attributes {
+		if attr.index >= len(record) {
+			continue
+		}
+		if value := strings.TrimSpace(record[attr.index]); value != "" {
+			row.Attributes = append(row.Attributes, model.WorkAttributeValue{Key: attr.key, Value: value})
+		}
+	}
+
+	return row, nil
+}
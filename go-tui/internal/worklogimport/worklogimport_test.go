@@ -0,0 +1,83 @@
+package worklogimport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseReadsAllColumns(t *testing.T) {
+	input := "\ufeffDate,Issue Key,Duration,Description,Start Time,attr:_Account_\n" +
+		"2026-04-01,grap-1,1h30m,\"Review, pairing\",9:00,ACC-1\n" +
+		",GRAP-2,0.5,,,\n"
+
+	rows, rowErrors, err := Parse(strings.NewReader(input), "2026-04-02")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rowErrors) != 0 {
+		t.Fatalf("unexpected row errors: %v", rowErrors)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	first := rows[0]
+	if first.Line != 2 || first.Date != "2026-04-01" || first.IssueKey != "GRAP-1" || first.Seconds != 5400 {
+		t.Errorf("unexpected first row: %+v", first)
+	}
+	if first.Description != "Review, pairing" || first.StartTime != "09:00:00" {
+		t.Errorf("unexpected description/start time: %+v", first)
+	}
+	if len(first.Attributes) != 1 || first.Attributes[0].Key != "_Account_" || first.Attributes[0].Value != "ACC-1" {
+		t.Errorf("unexpected attributes: %+v", first.Attributes)
+	}
+
+	second := rows[1]
+	if second.Date != "2026-04-02" || second.Seconds != 1800 || second.StartTime != "" || len(second.Attributes) != 0 {
+		t.Errorf("unexpected second row: %+v", second)
+	}
+}
+
+func TestParseReportsEveryInvalidRow(t *testing.T) {
+	input := "issue,time,date,start\n" +
+		"GRAP-1,2h,2026-04-01,\n" +
+		",1h,,\n" +
+		"GRAP-3,soon,,\n" +
+		"GRAP-4,1h,someday,\n" +
+		"GRAP-5,1h,,25:00\n"
+
+	rows, rowErrors, err := Parse(strings.NewReader(input), "2026-04-02")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 1 {
+		t.Errorf("expected 1 valid row, got %d", len(rows))
+	}
+
+	wantLines := []int{3, 4, 5, 6}
+	if len(rowErrors) != len(wantLines) {
+		t.Fatalf("expected %d row errors, got %v", len(wantLines), rowErrors)
+	}
+	for i, line := range wantLines {
+		if rowErrors[i].Line != line {
+			t.Errorf("error %d on line %d, want %d", i, rowErrors[i].Line, line)
+		}
+	}
+}
+
+func TestParseRejectsBadHeader(t *testing.T) {
+	tests := map[string]string{
+		"empty":            "",
+		"missing duration": "issue,date\nGRAP-1,today\n",
+		"unknown column":   "issue,duration,project\n",
+		"duplicate column": "issue,key,duration\n",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := Parse(strings.NewReader(input), "2026-04-02"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}